@@ -0,0 +1,197 @@
+// Package client talks to adventofcode.com. Inputs are cached on disk and
+// never downloaded twice, submitted answers are kept in a history so known
+// verdicts are never asked for again. Requests are throttled to be nice to
+// the site.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultMinInterval is the least time between two requests.
+	DefaultMinInterval = 5 * time.Second
+	userAgent          = "github.com/0x28F4/aoc2024 by 0x28F4"
+)
+
+// Doer sends http requests, *http.Client is one. Tests plug in the client of
+// an httptest server.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+var ErrNoSession = errors.New("no session cookie configured, set " + sessionEnv + " or add it to the config")
+
+// ErrLocked is returned for puzzles which are not unlocked yet.
+var ErrLocked = errors.New("puzzle is not unlocked yet")
+
+type Client struct {
+	BaseURL     string
+	Year        int
+	Session     string
+	CacheDir    string
+	HTTP        Doer
+	MinInterval time.Duration
+
+	mu   sync.Mutex
+	last time.Time
+
+	// replaced in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func New(cfg Config) *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Year:        cfg.Year,
+		Session:     cfg.Session,
+		CacheDir:    cfg.CacheDir,
+		HTTP:        http.DefaultClient,
+		MinInterval: DefaultMinInterval,
+		now:         time.Now,
+		sleep:       sleep,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// unlockZone is the time zone in which puzzles unlock at midnight.
+var unlockZone = time.FixedZone("EST", -5*60*60)
+
+// Unlocked reports whether the puzzle of day can be fetched already.
+func (c *Client) Unlocked(day int) bool {
+	unlock := time.Date(c.Year, time.December, day, 0, 0, 0, 0, unlockZone)
+	return !c.now().Before(unlock)
+}
+
+// dayDir is where everything belonging to a single day is cached.
+func (c *Client) dayDir(day int) string {
+	return filepath.Join(c.CacheDir, fmt.Sprint(c.Year), fmt.Sprintf("day%02d", day))
+}
+
+// Input returns the puzzle input of day, downloading it only if it is not
+// in the cache yet.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	path := filepath.Join(c.dayDir(day), "input")
+	if cached, err := os.ReadFile(path); err == nil {
+		return cached, nil
+	}
+
+	if !c.Unlocked(day) {
+		return nil, ErrLocked
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", c.Year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching input of day %d: %s: %s", day, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := writeFile(path, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// do sends an authenticated request, waiting for MinInterval to pass since
+// the previous one.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	if err := c.throttle(ctx); err != nil {
+		return nil, err
+	}
+	return c.HTTP.Do(req)
+}
+
+// lastRequestFile keeps the time of the latest request in the cache, every
+// run of aoc is a new process and a new Client.
+const lastRequestFile = "last-request"
+
+// throttle waits until MinInterval has passed since the latest request of
+// this or any earlier client sharing the cache.
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path := filepath.Join(c.CacheDir, lastRequestFile)
+	if data, err := os.ReadFile(path); err == nil {
+		// an unreadable timestamp just doesn't count
+		if last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data))); err == nil && last.After(c.last) {
+			c.last = last
+		}
+	}
+
+	if !c.last.IsZero() {
+		// min guards against a timestamp from the future
+		if wait := min(c.MinInterval, c.MinInterval-c.now().Sub(c.last)); wait > 0 {
+			if err := c.sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+	}
+	c.last = c.now()
+	return writeFile(path, []byte(c.last.Format(time.RFC3339Nano)+"\n"))
+}
+
+// writeFile writes through a temporary file, so an interrupted download
+// never leaves a truncated input in the cache.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
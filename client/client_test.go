@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+// fakeClock replaces time for the client, sleeping just advances it.
+type fakeClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func (f *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	f.slept = append(f.slept, d)
+	f.now = f.now.Add(d)
+	return nil
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *fakeClock) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	clock := &fakeClock{now: time.Date(2024, time.December, 26, 0, 0, 0, 0, time.UTC)}
+	c := New(Config{Session: "secret", Year: 2024, CacheDir: t.TempDir()})
+	c.BaseURL = server.URL
+	c.HTTP = server.Client()
+	c.now = clock.Now
+	c.sleep = clock.Sleep
+	return c, clock
+}
+
+func TestInputIsCached(t *testing.T) {
+	requests := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		utils.MustEq(r.URL.Path, "/2024/day/7/input")
+		cookie, err := r.Cookie("session")
+		utils.MustNil(err)
+		utils.MustEq(cookie.Value, "secret")
+		w.Write([]byte("190: 10 19\n"))
+	})
+
+	for range 3 {
+		input, err := c.Input(context.Background(), 7)
+		utils.MustNil(err)
+		utils.MustEq(string(input), "190: 10 19\n")
+	}
+	utils.MustEq(requests, 1)
+
+	// a fresh client, like the next run of aoc fetch, reads the same cache
+	again := New(Config{Session: "secret", Year: 2024, CacheDir: c.CacheDir})
+	again.HTTP = nil
+	input, err := again.Input(context.Background(), 7)
+	utils.MustNil(err)
+	utils.MustEq(string(input), "190: 10 19\n")
+}
+
+func TestInputErrorsAreNotCached(t *testing.T) {
+	requests := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+	})
+
+	_, err := c.Input(context.Background(), 3)
+	utils.MustNotNil(err)
+	_, err = c.Input(context.Background(), 3)
+	utils.MustNotNil(err)
+	utils.MustEq(requests, 2)
+}
+
+func TestThrottle(t *testing.T) {
+	c, clock := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("input\n"))
+	})
+	c.MinInterval = 5 * time.Second
+
+	for day := 1; day <= 3; day++ {
+		_, err := c.Input(context.Background(), day)
+		utils.MustNil(err)
+	}
+	utils.MustSliceEq(clock.slept, []time.Duration{5 * time.Second, 5 * time.Second})
+
+	clock.now = clock.now.Add(time.Minute)
+	_, err := c.Input(context.Background(), 4)
+	utils.MustNil(err)
+	utils.MustLen(clock.slept, 2)
+
+	// the next run of aoc waits for the requests of the previous one
+	again := New(Config{Session: "secret", Year: 2024, CacheDir: c.CacheDir})
+	again.BaseURL, again.HTTP = c.BaseURL, c.HTTP
+	again.now, again.sleep = clock.Now, clock.Sleep
+	clock.now = clock.now.Add(2 * time.Second)
+	_, err = again.Input(context.Background(), 5)
+	utils.MustNil(err)
+	utils.MustSliceEq(clock.slept, []time.Duration{5 * time.Second, 5 * time.Second, 3 * time.Second})
+}
+
+func TestLockedAndNoSession(t *testing.T) {
+	requests := 0
+	c, clock := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	clock.now = time.Date(2024, time.December, 10, 4, 59, 0, 0, time.UTC)
+	utils.MustTrue(c.Unlocked(9))
+	utils.MustFalse(c.Unlocked(10))
+	_, err := c.Input(context.Background(), 10)
+	utils.MustTrue(errors.Is(err, ErrLocked))
+
+	c.Session = ""
+	_, err = c.Input(context.Background(), 9)
+	utils.MustTrue(errors.Is(err, ErrNoSession))
+	utils.MustEq(requests, 0)
+}
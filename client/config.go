@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Config is read from <user config dir>/aoc/config.json:
+//
+//	{"session": "53616c74...", "year": 2024}
+//
+// The AOC_SESSION environment variable overrides the session cookie.
+type Config struct {
+	Session  string `json:"session"`
+	Year     int    `json:"year"`
+	CacheDir string `json:"cache_dir"`
+}
+
+const (
+	defaultYear = 2024
+	sessionEnv  = "AOC_SESSION"
+)
+
+// LoadConfig reads the config file if there is one and fills in defaults.
+func LoadConfig() (Config, error) {
+	var cfg Config
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return Config{}, err
+	}
+	bytes, err := os.ReadFile(filepath.Join(configDir, "aoc", "config.json"))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return Config{}, err
+	default:
+		if err := json.Unmarshal(bytes, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if session := os.Getenv(sessionEnv); session != "" {
+		cfg.Session = session
+	}
+	if cfg.Year == 0 {
+		cfg.Year = defaultYear
+	}
+	if cfg.CacheDir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return Config{}, err
+		}
+		cfg.CacheDir = filepath.Join(cacheDir, "aoc")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Verdict is how the site judged a submitted answer.
+type Verdict string
+
+const (
+	Correct Verdict = "correct"
+	TooHigh Verdict = "too high"
+	TooLow  Verdict = "too low"
+	Wrong   Verdict = "wrong"
+)
+
+type Submission struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// History is every answer submitted for one day, kept in the cache next to
+// the input so known verdicts never have to be asked for again.
+type History struct {
+	Submissions []Submission `json:"submissions"`
+	LockedUntil time.Time    `json:"locked_until"`
+}
+
+const historyFile = "history.json"
+
+var (
+	ErrSolved     = errors.New("part is already solved")
+	ErrKnownWrong = errors.New("answer was already rejected")
+)
+
+// LockoutError is returned while the site doesn't accept answers after a
+// wrong one.
+type LockoutError struct {
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("locked out until %s", e.Until.Local().Format(time.TimeOnly))
+}
+
+// BoundsError rejects an answer that can't be right given earlier too high
+// and too low verdicts.
+type BoundsError struct {
+	Answer, Low, High string
+}
+
+func (e *BoundsError) Error() string {
+	switch {
+	case e.Low == "":
+		return fmt.Sprintf("%s is not below %s, which is too high", e.Answer, e.High)
+	case e.High == "":
+		return fmt.Sprintf("%s is not above %s, which is too low", e.Answer, e.Low)
+	default:
+		return fmt.Sprintf("%s is not between %s and %s", e.Answer, e.Low, e.High)
+	}
+}
+
+func loadHistory(path string) (*History, error) {
+	h := &History{}
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, h); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return h, nil
+}
+
+func (h *History) save(path string) error {
+	bytes, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(path, append(bytes, '\n'))
+}
+
+// Correct returns the accepted answer of part, if there is one.
+func (h *History) Correct(part int) (string, bool) {
+	for _, s := range h.Submissions {
+		if s.Part == part && s.Verdict == Correct {
+			return s.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Bounds returns the highest answer known to be too low and the lowest one
+// known to be too high, ok is false if there is no such answer.
+func (h *History) Bounds(part int) (low int, lowOk bool, high int, highOk bool) {
+	for _, s := range h.Submissions {
+		if s.Part != part {
+			continue
+		}
+		v, err := strconv.Atoi(s.Answer)
+		if err != nil {
+			continue
+		}
+		switch s.Verdict {
+		case TooLow:
+			if !lowOk || v > low {
+				low, lowOk = v, true
+			}
+		case TooHigh:
+			if !highOk || v < high {
+				high, highOk = v, true
+			}
+		}
+	}
+	return low, lowOk, high, highOk
+}
+
+// Check returns an error if answer doesn't need to be sent because the
+// history already tells it can't be right, or the site wouldn't take it now.
+func (h *History) Check(part int, answer string, now time.Time) error {
+	if _, solved := h.Correct(part); solved {
+		return ErrSolved
+	}
+	for _, s := range h.Submissions {
+		if s.Part == part && s.Answer == answer {
+			return fmt.Errorf("%w: %s was %s", ErrKnownWrong, answer, s.Verdict)
+		}
+	}
+
+	if v, err := strconv.Atoi(answer); err == nil {
+		low, lowOk, high, highOk := h.Bounds(part)
+		if (lowOk && v <= low) || (highOk && v >= high) {
+			e := &BoundsError{Answer: answer}
+			if lowOk {
+				e.Low = strconv.Itoa(low)
+			}
+			if highOk {
+				e.High = strconv.Itoa(high)
+			}
+			return e
+		}
+	}
+
+	if now.Before(h.LockedUntil) {
+		return &LockoutError{Until: h.LockedUntil}
+	}
+	return nil
+}
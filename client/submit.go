@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// History returns the submissions made for day so far.
+func (c *Client) History(day int) (*History, error) {
+	return loadHistory(filepath.Join(c.dayDir(day), historyFile))
+}
+
+// Submit sends answer for part of day unless the history already knows the
+// outcome. Every verdict from the site is recorded in the history.
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Verdict, error) {
+	path := filepath.Join(c.dayDir(day), historyFile)
+	history, err := loadHistory(path)
+	if err != nil {
+		return "", err
+	}
+	if err := history.Check(part, answer, c.now()); err != nil {
+		return "", err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", c.Year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("submitting day %d part %d: %s", day, part, resp.Status)
+	}
+
+	verdict, wait, err := parseVerdict(string(body))
+	now := c.now()
+	if wait > 0 {
+		history.LockedUntil = now.Add(wait)
+	}
+	if err == errTooRecently {
+		err = &LockoutError{Until: history.LockedUntil}
+	}
+	if err == nil {
+		history.Submissions = append(history.Submissions, Submission{Part: part, Answer: answer, Verdict: verdict, Time: now})
+	}
+	if err := history.save(path); err != nil {
+		return "", err
+	}
+	return verdict, err
+}
+
+// errTooRecently is the site refusing an answer during a lockout we didn't
+// know about.
+var errTooRecently = errors.New("answer sent too recently")
+
+var (
+	articleRe = regexp.MustCompile(`(?s)<article>(.*?)</article>`)
+	tagRe     = regexp.MustCompile(`<[^>]*>`)
+	leftRe    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitRe    = regexp.MustCompile(`(?i)please wait (one|\d+) minutes?`)
+)
+
+// parseVerdict reads the verdict and the time until the next answer is
+// accepted from the page returned for a submission.
+func parseVerdict(page string) (Verdict, time.Duration, error) {
+	text := page
+	if m := articleRe.FindStringSubmatch(page); m != nil {
+		text = tagRe.ReplaceAllString(m[1], "")
+	}
+	text = strings.Join(strings.Fields(text), " ")
+
+	var wait time.Duration
+	if m := leftRe.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := waitRe.FindStringSubmatch(text); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		wait = time.Duration(minutes) * time.Minute
+	}
+
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		return Correct, wait, nil
+	case strings.Contains(text, "You gave an answer too recently"):
+		return "", wait, errTooRecently
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		return "", wait, ErrSolved
+	case strings.Contains(text, "your answer is too high"):
+		return TooHigh, wait, nil
+	case strings.Contains(text, "your answer is too low"):
+		return TooLow, wait, nil
+	case strings.Contains(text, "That's not the right answer"):
+		return Wrong, wait, nil
+	}
+	if len(text) > 200 {
+		text = text[:200]
+	}
+	return "", wait, fmt.Errorf("unrecognized response: %q", text)
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func page(text string) string {
+	return fmt.Sprintf("<html><body><main>\n<article><p>%s</p></article>\n</main></body></html>", text)
+}
+
+// fakeSite judges answers against want the way the real site words it.
+func fakeSite(want int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		utils.MustEq(r.Method, http.MethodPost)
+		utils.MustEq(r.URL.Path, "/2024/day/7/answer")
+		utils.MustNil(r.ParseForm())
+		utils.MustEq(r.PostForm.Get("level"), "1")
+
+		answer, err := strconv.Atoi(r.PostForm.Get("answer"))
+		utils.MustNil(err)
+		switch {
+		case answer == want:
+			fmt.Fprint(w, page("That's the right answer!  You are <em>one gold star</em> closer to finding the Chief Historian."))
+		case answer > want:
+			fmt.Fprint(w, page("That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data.  Please wait one minute before trying again. <a href=\"/2024/day/7\">[Return to Day 7]</a>"))
+		default:
+			fmt.Fprint(w, page("That's not the right answer; your answer is too low.  Please wait one minute before trying again. <a href=\"/2024/day/7\">[Return to Day 7]</a>"))
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	requests := 0
+	c, clock := newTestClient(t, fakeSite(3749, &requests))
+	ctx := context.Background()
+
+	verdict, err := c.Submit(ctx, 7, 1, "5000")
+	utils.MustNil(err)
+	utils.MustEq(verdict, TooHigh)
+
+	// still locked out after the wrong answer
+	_, err = c.Submit(ctx, 7, 1, "3000")
+	var lockout *LockoutError
+	utils.MustTrue(errors.As(err, &lockout))
+	utils.MustEq(lockout.Until, clock.now.Add(time.Minute))
+
+	clock.now = clock.now.Add(time.Minute)
+	_, err = c.Submit(ctx, 7, 1, "5000")
+	utils.MustTrue(errors.Is(err, ErrKnownWrong))
+	_, err = c.Submit(ctx, 7, 1, "6000")
+	var bounds *BoundsError
+	utils.MustTrue(errors.As(err, &bounds))
+
+	verdict, err = c.Submit(ctx, 7, 1, "3000")
+	utils.MustNil(err)
+	utils.MustEq(verdict, TooLow)
+	clock.now = clock.now.Add(time.Minute)
+
+	_, err = c.Submit(ctx, 7, 1, "2999")
+	utils.MustTrue(errors.As(err, &bounds))
+	utils.MustEq(bounds.Error(), "2999 is not between 3000 and 5000")
+
+	verdict, err = c.Submit(ctx, 7, 1, "3749")
+	utils.MustNil(err)
+	utils.MustEq(verdict, Correct)
+
+	_, err = c.Submit(ctx, 7, 1, "3749")
+	utils.MustTrue(errors.Is(err, ErrSolved))
+	utils.MustEq(requests, 3)
+
+	history, err := c.History(7)
+	utils.MustNil(err)
+	utils.MustLen(history.Submissions, 3)
+	answer, ok := history.Correct(1)
+	utils.MustTrue(ok)
+	utils.MustEq(answer, "3749")
+	_, ok = history.Correct(2)
+	utils.MustFalse(ok)
+}
+
+func TestSubmitTooRecently(t *testing.T) {
+	requests := 0
+	c, clock := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, page("You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 2s left to wait. <a href=\"/2024/day/7\">[Return to Day 7]</a>"))
+	})
+
+	_, err := c.Submit(context.Background(), 7, 1, "42")
+	var lockout *LockoutError
+	utils.MustTrue(errors.As(err, &lockout))
+	utils.MustEq(lockout.Until, clock.now.Add(4*time.Minute+2*time.Second))
+
+	// the lockout is remembered, nothing was recorded as wrong
+	_, err = c.Submit(context.Background(), 7, 1, "42")
+	utils.MustTrue(errors.As(err, &lockout))
+	utils.MustEq(requests, 1)
+
+	history, err := c.History(7)
+	utils.MustNil(err)
+	utils.MustLen(history.Submissions, 0)
+}
+
+func TestParseVerdict(t *testing.T) {
+	verdict, wait, err := parseVerdict(page("That's not the right answer.  If you're stuck, make sure you're using the full input data.  Because you have guessed incorrectly 4 times on this puzzle, please wait 5 minutes before trying again."))
+	utils.MustNil(err)
+	utils.MustEq(verdict, Wrong)
+	utils.MustEq(wait, 5*time.Minute)
+
+	_, _, err = parseVerdict(page("You don't seem to be solving the right level.  Did you already complete it?"))
+	utils.MustTrue(errors.Is(err, ErrSolved))
+
+	_, _, err = parseVerdict("<html>maintenance</html>")
+	utils.MustNotNil(err)
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// benchResult is the cost of a single run of one part.
+type benchResult struct {
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// baseline maps "day07/part1" to its result.
+type baseline map[string]benchResult
+
+func benchKey(day, part int) string {
+	return fmt.Sprintf("%s/part%d", solver.Dir(day), part)
+}
+
+// bench times every part of the selected days against dayNN/input. With
+// --compare, parts which got slower than the baseline by more than
+// --threshold make the command fail.
+func bench(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	benchtime := flags.String("benchtime", "1s", "run each part for this long, or Nx for N times")
+	save := flags.String("save", "", "save the results as baseline to this file")
+	compare := flags.String("compare", "", "compare the results against the baseline in this file")
+	threshold := flags.Float64("threshold", 0.1, "slow down relative to the baseline counted as regression")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errors.New("bench expects at most one day or \"all\"")
+	}
+	selection := "all"
+	if len(positional) == 1 {
+		selection = positional[0]
+	}
+	days, err := parseDays(selection)
+	if err != nil {
+		return err
+	}
+
+	var base baseline
+	if *compare != "" {
+		data, err := os.ReadFile(*compare)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &base); err != nil {
+			return fmt.Errorf("%s: %w", *compare, err)
+		}
+	}
+
+	budget, err := parseBenchtime(*benchtime)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	header := "day\tpart\ttime\tallocs\tbytes\t"
+	if base != nil {
+		header += "baseline\tdelta\t"
+	}
+	fmt.Fprintln(w, header)
+
+	results := baseline{}
+	regressions := 0
+	for _, day := range days {
+		path := filepath.Join(solver.Dir(day), "input")
+		input, err := os.ReadFile(path)
+		if err != nil || len(input) == 0 {
+			fmt.Fprintf(os.Stderr, "day %d: no input, skipping\n", day)
+			continue
+		}
+		s, _ := solver.Get(day)
+
+		for _, part := range []int{1, 2} {
+			// a part that fails or panics would take the whole benchmark down
+			if _, err := runPart(day, part, path, nil); err != nil {
+				fmt.Fprintf(os.Stderr, "day %d part %d: %s\n", day, part, err)
+				continue
+			}
+			result, err := measure(s, part, input, budget)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "day %d part %d: %s\n", day, part, err)
+				continue
+			}
+			results[benchKey(day, part)] = result
+
+			row := fmt.Sprintf("%d\t%d\t%s\t%d\t%d\t", day, part, time.Duration(result.NsPerOp), result.AllocsPerOp, result.BytesPerOp)
+			if base != nil {
+				if old, ok := base[benchKey(day, part)]; ok && old.NsPerOp <= 0 {
+					// a broken baseline has nothing to compare against
+					row += fmt.Sprintf("%s\tn/a\t", time.Duration(old.NsPerOp))
+				} else if ok {
+					delta := float64(result.NsPerOp-old.NsPerOp) / float64(old.NsPerOp)
+					mark := ""
+					if delta > *threshold {
+						mark = " !"
+						regressions++
+					}
+					row += fmt.Sprintf("%s\t%+.1f%%%s\t", time.Duration(old.NsPerOp), 100*delta, mark)
+				} else {
+					row += "-\t-\t"
+				}
+			}
+			fmt.Fprintln(w, row)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if *save != "" {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*save, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d parts are more than %.0f%% slower than the baseline", regressions, 100**threshold)
+	}
+	return nil
+}
+
+// benchBudget is how long to run each part, like go test's -benchtime
+// either a duration or a fixed number of runs.
+type benchBudget struct {
+	d time.Duration
+	n int
+}
+
+func parseBenchtime(s string) (benchBudget, error) {
+	if n, found := strings.CutSuffix(s, "x"); found {
+		runs, err := strconv.Atoi(n)
+		if err != nil || runs <= 0 {
+			return benchBudget{}, fmt.Errorf("invalid benchtime %q", s)
+		}
+		return benchBudget{n: runs}, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return benchBudget{}, fmt.Errorf("invalid benchtime %q", s)
+	}
+	return benchBudget{d: d}, nil
+}
+
+// measure runs part of s against input until the budget is used up and
+// returns the average cost of a single run.
+func measure(s solver.Solver, part int, input []byte, budget benchBudget) (benchResult, error) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	runs := 0
+	for {
+		if _, err := solver.Part(s, part, bytes.NewReader(input)); err != nil {
+			return benchResult{}, err
+		}
+		runs++
+		if budget.n > 0 && runs >= budget.n || budget.n == 0 && time.Since(start) >= budget.d {
+			break
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	n := int64(runs)
+	return benchResult{
+		NsPerOp:     elapsed.Nanoseconds() / n,
+		AllocsPerOp: int64(after.Mallocs-before.Mallocs) / n,
+		BytesPerOp:  int64(after.TotalAlloc-before.TotalAlloc) / n,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package main
+
+// every day registers its solver on import, `make day` appends new days here
+import (
+	_ "github.com/0x28F4/aoc2024/day01"
+	_ "github.com/0x28F4/aoc2024/day02"
+	_ "github.com/0x28F4/aoc2024/day03"
+	_ "github.com/0x28F4/aoc2024/day04"
+	_ "github.com/0x28F4/aoc2024/day05"
+	_ "github.com/0x28F4/aoc2024/day06"
+	_ "github.com/0x28F4/aoc2024/day07"
+	_ "github.com/0x28F4/aoc2024/day08"
+	_ "github.com/0x28F4/aoc2024/day09"
+	_ "github.com/0x28F4/aoc2024/day10"
+	_ "github.com/0x28F4/aoc2024/day11"
+	_ "github.com/0x28F4/aoc2024/day12"
+	_ "github.com/0x28F4/aoc2024/day13"
+	_ "github.com/0x28F4/aoc2024/day14"
+	_ "github.com/0x28F4/aoc2024/day15"
+	_ "github.com/0x28F4/aoc2024/day17"
+	_ "github.com/0x28F4/aoc2024/day18"
+	_ "github.com/0x28F4/aoc2024/day19"
+)
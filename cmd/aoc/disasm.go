@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/0x28F4/aoc2024/day17"
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// disasm prints the program of a day 17 input as pseudo-code, example files
+// work as well.
+func disasm(args []string) error {
+	fs := flag.NewFlagSet("disasm", flag.ExitOnError)
+	input := fs.String("input", filepath.Join(solver.Dir(17), "input"), "select input file")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errors.New("disasm takes no arguments")
+	}
+
+	data, err := os.ReadFile(*input)
+	if err != nil {
+		return err
+	}
+	if solver.IsExample(data) {
+		ex, err := solver.ParseExample(*input, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		data = []byte(ex.Input)
+	}
+	return day17.DisassembleInput(bytes.NewReader(data), os.Stdout)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/0x28F4/aoc2024/client"
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// fetch downloads the inputs of the selected days into dayNN/input. The
+// client keeps its own cache, so fetching again never hits the site.
+func fetch(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("fetch expects exactly one day or \"all\"")
+	}
+
+	days, err := fetchDays(positional[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, err := client.LoadConfig()
+	if err != nil {
+		return err
+	}
+	c := client.New(cfg)
+
+	for _, day := range days {
+		path := filepath.Join(solver.Dir(day), "input")
+		// make day copies an empty placeholder from the template
+		if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+			fmt.Printf("day %d: %s already exists\n", day, path)
+			continue
+		} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		input, err := c.Input(context.Background(), day)
+		if errors.Is(err, client.ErrLocked) {
+			fmt.Printf("day %d: not unlocked yet\n", day)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, input, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("day %d: wrote %s\n", day, path)
+	}
+	return nil
+}
+
+// lastDay is the last puzzle of an event.
+const lastDay = 25
+
+// fetchDays is parseDays for days which may have no solver yet, "all"
+// selects every day of the event.
+func fetchDays(arg string) ([]int, error) {
+	if arg == "all" {
+		days := make([]int, 0, lastDay)
+		for day := 1; day <= lastDay; day++ {
+			days = append(days, day)
+		}
+		return days, nil
+	}
+
+	day, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(arg), "day"))
+	if err != nil || day < 1 || day > lastDay {
+		return nil, fmt.Errorf("invalid day %q, expected 1 to %d", arg, lastDay)
+	}
+	return []int{day}, nil
+}
@@ -0,0 +1,104 @@
+// Command aoc runs the registered puzzle solvers.
+//
+//	aoc run 7 --part 2 --input day07/examples/example.txt
+//	aoc run all
+//	aoc verify
+//	aoc fetch 7
+//	aoc submit 7 1
+//	aoc bench all --compare bench.json
+//	aoc disasm --input day17/examples/example1.txt
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+var commands = map[string]func(args []string) error{
+	"run":    run,
+	"verify": verify,
+	"fetch":  fetch,
+	"submit": submit,
+	"bench":  bench,
+	"disasm": disasm,
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  run <day|all> [--part n] [--input file] [--param name=value]... [--record]")
+	fmt.Fprintln(os.Stderr, "  verify [day|all]")
+	fmt.Fprintln(os.Stderr, "  fetch <day|all>")
+	fmt.Fprintln(os.Stderr, "  submit <day> <part> [answer]")
+	fmt.Fprintln(os.Stderr, "  bench [day|all] [--benchtime 1s] [--save file] [--compare file] [--threshold 0.1]")
+	fmt.Fprintln(os.Stderr, "  disasm [--input file]")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, exists := commands[os.Args[1]]
+	if !exists {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
+
+// parseArgs parses flags which may appear before, between or after the
+// positional arguments and returns the positional ones.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// parseDays turns "all", a day number or a day directory like "day07" into
+// the list of days to run.
+func parseDays(arg string) ([]int, error) {
+	if arg == "all" {
+		return solver.Days(), nil
+	}
+
+	day, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(arg), "day"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", arg)
+	}
+	if _, exists := solver.Get(day); !exists {
+		return nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+	return []int{day}, nil
+}
+
+// parts returns the parts selected by the --part flag, 0 selects both.
+func parts(part int) ([]int, error) {
+	switch part {
+	case 0:
+		return []int{1, 2}, nil
+	case 1, 2:
+		return []int{part}, nil
+	}
+	return nil, fmt.Errorf("invalid part %d", part)
+}
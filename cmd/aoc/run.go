@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	part := fs.Int("part", 0, "select part, 0 runs both")
+	input := fs.String("input", "", "select input file, defaults to dayNN/input")
+	record := fs.Bool("record", false, "record the answers as accepted in dayNN/answers.json")
+	var params solver.Params
+	fs.Var(&params, "param", "set a puzzle param like width=11, can be repeated")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("run expects exactly one day or \"all\"")
+	}
+
+	days, err := parseDays(positional[0])
+	if err != nil {
+		return err
+	}
+	if (*input != "" || len(params) > 0) && len(days) > 1 {
+		return errors.New("--input and --param can only be used with a single day")
+	}
+	selected, err := parts(*part)
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, day := range days {
+		path := *input
+		if path == "" {
+			path = filepath.Join(solver.Dir(day), "input")
+		}
+		for _, p := range selected {
+			start := time.Now()
+			answer, err := runPart(day, p, path, params)
+			if err != nil {
+				failed = true
+				fmt.Fprintf(os.Stderr, "day %d part %d: %s\n", day, p, err)
+				continue
+			}
+			fmt.Printf("day %d part %d: %v (%s)\n", day, p, answer, time.Since(start).Round(time.Microsecond))
+
+			if *record {
+				if err := recordAnswer(day, p, path, params, answer); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if failed {
+		return errors.New("some parts failed")
+	}
+	return nil
+}
+
+// recordAnswer stores answer as accepted for the given input, which has to
+// live inside the day directory so verify can find it again.
+func recordAnswer(day, part int, path string, params solver.Params, answer solver.Answer) error {
+	dir := solver.Dir(day)
+	input, err := filepath.Rel(dir, path)
+	if err != nil || strings.HasPrefix(input, "..") {
+		return fmt.Errorf("can only record answers for inputs inside %s, got %s", dir, path)
+	}
+
+	answers, err := solver.LoadAnswers(dir)
+	if err != nil {
+		return err
+	}
+	if err := answers.Record(filepath.ToSlash(input), params, part, answer); err != nil {
+		return err
+	}
+	return answers.Save(dir)
+}
+
+// runPart runs a single part against the input at path. Example files are
+// recognized by their header, their params are applied below the ones given
+// and the answer is checked against the expected one. Parse errors are
+// reported with the position in the input, panics of the remaining utils.Must*
+// helpers are turned into errors so that one broken day doesn't stop the others.
+func runPart(day, part int, path string, params solver.Params) (answer solver.Answer, err error) {
+	s, exists := solver.Get(day)
+	if !exists {
+		return solver.Answer{}, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	var ex solver.Example
+	if solver.IsExample(input) {
+		if ex, err = solver.ParseExample(path, bytes.NewReader(input)); err != nil {
+			return solver.Answer{}, err
+		}
+		merged := make(solver.Params)
+		maps.Copy(merged, ex.Params)
+		maps.Copy(merged, params)
+		params = merged
+		input = []byte(ex.Input)
+	}
+
+	if s, err = solver.Configure(s, params); err != nil {
+		return solver.Answer{}, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", path, r)
+		}
+	}()
+
+	answer, err = solver.Part(s, part, bytes.NewReader(input))
+	var pe *parse.Error
+	if errors.As(err, &pe) {
+		pe.File = path
+		if pe.Line > 0 {
+			pe.Line += ex.Offset
+		}
+		return solver.Answer{}, err
+	}
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	if want, ok := ex.Want.Get(part); ok && answer.String() != want {
+		return solver.Answer{}, fmt.Errorf("%s: got %s, want %s", path, answer, want)
+	}
+	return answer, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/0x28F4/aoc2024/client"
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// submit sends the answer of a part, computed from dayNN/input unless given
+// on the command line. Accepted answers are recorded in dayNN/answers.json.
+func submit(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 || len(positional) > 3 {
+		return errors.New("submit expects a day, a part and optionally the answer")
+	}
+
+	days, err := parseDays(positional[0])
+	if err != nil {
+		return err
+	}
+	if len(days) != 1 {
+		return errors.New("submit expects a single day")
+	}
+	day := days[0]
+	part, err := strconv.Atoi(positional[1])
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("invalid part %q", positional[1])
+	}
+
+	path := filepath.Join(solver.Dir(day), "input")
+	var answer solver.Answer
+	if len(positional) == 3 {
+		answer = parseAnswer(positional[2])
+	} else if answer, err = runPart(day, part, path, nil); err != nil {
+		return err
+	}
+
+	cfg, err := client.LoadConfig()
+	if err != nil {
+		return err
+	}
+	verdict, err := client.New(cfg).Submit(context.Background(), day, part, answer.String())
+	if err != nil {
+		return fmt.Errorf("day %d part %d: %w", day, part, err)
+	}
+	fmt.Printf("day %d part %d: %v is %s\n", day, part, answer, verdict)
+
+	if verdict == client.Correct {
+		return recordAnswer(day, part, path, nil, answer)
+	}
+	return nil
+}
+
+// parseAnswer keeps answers typed on the command line numeric where possible,
+// so they compare like the ones returned by solvers.
+func parseAnswer(s string) solver.Answer {
+	if i, err := strconv.Atoi(s); err == nil {
+		return solver.Int(i)
+	}
+	return solver.String(s)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// verify re-runs every recorded input of the selected days and compares the
+// results against the accepted answers in dayNN/answers.json.
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errors.New("verify expects at most one day or \"all\"")
+	}
+	selection := "all"
+	if len(positional) == 1 {
+		selection = positional[0]
+	}
+
+	days, err := parseDays(selection)
+	if err != nil {
+		return err
+	}
+
+	var checked, failed int
+	for _, day := range days {
+		dir := solver.Dir(day)
+		answers, err := solver.LoadAnswers(dir)
+		if err != nil {
+			return err
+		}
+
+		for _, input := range answers.Inputs() {
+			path := filepath.Join(dir, input)
+			if _, err := os.Stat(path); err != nil {
+				fmt.Printf("day %d %s: skipped, %s\n", day, input, err)
+				continue
+			}
+
+			for _, part := range []int{1, 2} {
+				want, ok := answers[input].Get(part)
+				if !ok {
+					continue
+				}
+
+				checked++
+				got, err := runPart(day, part, path, answers[input].Params)
+				switch {
+				case err != nil:
+					failed++
+					fmt.Printf("day %d %s part %d: FAIL %s\n", day, input, part, err)
+				case got.String() != want:
+					failed++
+					fmt.Printf("day %d %s part %d: FAIL got %s, want %s\n", day, input, part, got, want)
+				default:
+					fmt.Printf("day %d %s part %d: ok\n", day, input, part)
+				}
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d answers failed verification", failed, checked)
+	}
+	fmt.Printf("verified %d answers\n", checked)
+	return nil
+}
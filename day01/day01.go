@@ -0,0 +1,70 @@
+package day01
+
+import (
+	"io"
+	"sort"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/counter"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func init() {
+	solver.Register(1, Solver{})
+}
+
+type Solver struct{}
+
+func handleInput(r io.Reader) ([]int, []int, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l1 := make([]int, 0)
+	l2 := make([]int, 0)
+	for _, line := range lines {
+		items, err := line.Fields(2)
+		if err != nil {
+			return nil, nil, err
+		}
+		nums, err := parse.IntsOf(items)
+		if err != nil {
+			return nil, nil, err
+		}
+		l1 = append(l1, nums[0])
+		l2 = append(l2, nums[1])
+	}
+	return l1, l2, nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	l1, l2, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	sort.Ints(l1)
+	sort.Ints(l2)
+	totalDist := 0
+	for i, left := range l1 {
+		right := l2[i]
+		totalDist += utils.Abs(right - left)
+	}
+	return solver.Int(totalDist), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	l1, l2, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	freq := counter.FromSlice(l2)
+	score := 0
+	for _, v := range l1 {
+		score += v * freq.Get(v)
+	}
+	return solver.Int(score), nil
+}
@@ -1,15 +1,19 @@
-package main
+package day02
 
 import (
-	"flag"
-	"fmt"
 	"io"
-	"os"
-	"strings"
 
+	"github.com/0x28F4/aoc2024/solver"
 	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/parse"
 )
 
+func init() {
+	solver.Register(2, Solver{})
+}
+
+type Solver struct{}
+
 type level struct {
 	numbers []int
 	isAsc   bool
@@ -67,25 +71,31 @@ func (l level) findAlts() []level {
 	return alts
 }
 
-func solve() {
-	file, err := os.Open("./input_d2")
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-
-	input := string(bytes)
-	lines := strings.Split(input, "\n")
+func handleInput(r io.Reader) ([]level, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
 
 	levels := make([]level, 0)
 	for _, line := range lines {
-		items := strings.Fields(line)
-		nums := make([]int, 0)
-		for _, item := range items {
-			nums = append(nums, utils.MustInt(item))
+		nums, err := line.Ints()
+		if err != nil {
+			return nil, err
+		}
+		if len(nums) < 2 {
+			return nil, line.Errorf("expected at least 2 levels, got %d", len(nums))
 		}
 		levels = append(levels, newLevel(nums))
 	}
+	return levels, nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	levels, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 
 	score := 0
 	for _, level := range levels {
@@ -95,27 +105,13 @@ func solve() {
 		score += 1
 	}
 
-	fmt.Println(score)
+	return solver.Int(score), nil
 }
 
-func solveB() {
-	file, err := os.Open("./input_d2")
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-
-	input := string(bytes)
-	lines := strings.Split(input, "\n")
-
-	levels := make([]level, 0)
-	for _, line := range lines {
-		items := strings.Fields(line)
-		nums := make([]int, 0)
-		for _, item := range items {
-			nums = append(nums, utils.MustInt(item))
-		}
-		levels = append(levels, newLevel(nums))
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	levels, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
 	}
 
 	score := 0
@@ -138,16 +134,5 @@ func solveB() {
 		}
 	}
 
-	fmt.Println(score)
-}
-
-var isPartTwo = flag.Bool("b", false, "select if part two")
-
-func main() {
-	flag.Parse()
-	if *isPartTwo {
-		solveB()
-	} else {
-		solve()
-	}
+	return solver.Int(score), nil
 }
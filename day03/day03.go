@@ -1,15 +1,19 @@
-package main
+package day03
 
 import (
-	"flag"
-	"fmt"
 	"io"
-	"os"
 	"strconv"
 
+	"github.com/0x28F4/aoc2024/solver"
 	"github.com/0x28F4/aoc2024/utils"
 )
 
+func init() {
+	solver.Register(3, Solver{})
+}
+
+type Solver struct{}
+
 type mult struct {
 	enabled bool
 	a       int
@@ -114,31 +118,36 @@ func parse(raw string) []mult {
 	return insts
 }
 
-var isPartTwo = flag.Bool("b", false, "select if part two")
-var inputFile = flag.String("input", "day3/input", "select input file")
-
-func main() {
-	flag.Parse()
-	solve()
+func handleInput(r io.Reader) (string, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
 }
 
-func handleInput() string {
-	file, err := os.Open(*inputFile)
-	utils.HandleError(err)
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-	return string(bytes)
-}
+func solve(r io.Reader, isPartTwo bool) (solver.Answer, error) {
+	raw, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 
-func solve() {
-	mults := parse(handleInput())
+	mults := parse(raw)
 	score := 0
 	for _, mult := range mults {
 		add := mult.a * mult.b
-		if *isPartTwo && !mult.enabled {
+		if isPartTwo && !mult.enabled {
 			add = 0
 		}
 		score += add
 	}
-	fmt.Println(score)
+	return solver.Int(score), nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	return solve(r, false)
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	return solve(r, true)
 }
@@ -0,0 +1,142 @@
+package day04
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func init() {
+	solver.Register(4, Solver{})
+}
+
+type Solver struct{}
+
+// handleInput returns the word search surrounded by a border of #.
+func handleInput(r io.Reader) (grid.Grid[byte], error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return grid.Grid[byte]{}, err
+	}
+
+	g, err := parse.Grid(lines, parse.Byte)
+	if err != nil {
+		return grid.Grid[byte]{}, err
+	}
+	return g.Padded('#'), nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	con, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	points := []point.Point{{X: 0, Y: 0}}
+	cur := point.Point{X: 0, Y: 0}
+	s := 0
+	for {
+		prev := cur
+		cur = cur.Move(boundDirs[s])
+		if (cur.X == 0) && (cur.Y == 0) {
+			break
+		}
+		if v, err := con.At(cur); err == nil {
+			points = append(points, cur)
+			utils.MustEq(v, byte('#'))
+		} else {
+			cur = prev
+			s += 1
+		}
+
+	}
+	utils.MustLen(points, con.Width()*2+con.Height()*2-4)
+
+	scannedLines := make([][]byte, 0)
+	for _, dir := range point.Directions {
+		for _, p := range points {
+			var line []byte
+			for cur := p; con.InBounds(cur); cur = cur.Move(dir) {
+				v, _ := con.At(cur)
+				line = append(line, v)
+			}
+			scannedLines = append(scannedLines, line)
+		}
+	}
+
+	score := 0
+	for _, line := range scannedLines {
+		lScore := bytes.Count(line, []byte("XMAS"))
+		score += lScore
+	}
+	return solver.Int(score), nil
+}
+
+// boundDirs walk around the border: down right up left
+var boundDirs = []point.Direction{point.Down, point.Right, point.Up, point.Left}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	con, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	score := 0
+	for y := 0; y < con.Height(); y++ {
+		for x := 0; x < con.Width(); x++ {
+			for _, sten := range stencils {
+				sum := sten.conv(con, x, y)
+				if sum == 5 {
+					score += 1
+				}
+			}
+		}
+	}
+	return solver.Int(score), nil
+}
+
+type stencil []string
+
+func (s stencil) conv(con grid.Grid[byte], x, y int) (sum int) {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			v := 0
+			sP := s[j][i]
+			if cP, err := con.At(point.Point{X: x + i, Y: y + j}); err == nil {
+				if sP == cP {
+					v = 1
+				}
+			}
+			sum += v
+		}
+	}
+	return
+}
+
+var stencils = []stencil{
+	{
+		"M*S",
+		"*A*",
+		"M*S",
+	},
+	{
+		"S*M",
+		"*A*",
+		"S*M",
+	},
+	{
+		"M*M",
+		"*A*",
+		"S*S",
+	},
+	{
+		"S*S",
+		"*A*",
+		"M*M",
+	},
+}
@@ -0,0 +1,128 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func init() {
+	solver.Register(5, Solver{})
+}
+
+type Solver struct{}
+
+type rule struct {
+	lhs int
+	rhs int
+}
+
+func (r rule) apply(line []int) error {
+	for i := 0; i < len(line); i++ {
+		before := line[0:i]
+		// after := line[i+1:]
+		v := line[i]
+
+		if r.lhs == v && slices.Contains(before, r.rhs) {
+			return fmt.Errorf("violates rule %d|%d", r.lhs, r.rhs)
+		}
+	}
+
+	return nil
+}
+
+func handleInput(r io.Reader) (rules []rule, sequences [][]int, err error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("expected rules and sequences, got %d sections", len(sections))
+	}
+
+	for _, raw := range sections[0] {
+		nums, err := raw.IntList("|")
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(nums) != 2 {
+			return nil, nil, raw.Errorf("expected a rule like 47|53, got %q", raw.Text)
+		}
+
+		rules = append(rules, rule{nums[0], nums[1]})
+	}
+
+	for _, seq := range sections[1] {
+		ret, err := seq.IntList(",")
+		if err != nil {
+			return nil, nil, err
+		}
+		sequences = append(sequences, ret)
+	}
+	return rules, sequences, nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	rules, sequences, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	score := 0
+	for _, seq := range sequences {
+		valid := true
+		for _, rule := range rules {
+			if err := rule.apply(seq); err != nil {
+				valid = false
+				break
+			}
+
+		}
+		if valid {
+			mid := seq[len(seq)/2]
+			score += mid
+		}
+	}
+	return solver.Int(score), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	rules, sequences, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	score := 0
+	for _, seq := range sequences {
+		invalid := false
+		for _, rule := range rules {
+			if err := rule.apply(seq); err != nil {
+				invalid = true
+				break
+			}
+		}
+
+		if invalid {
+			isFixed := false
+			fixed := seq
+			for !isFixed {
+				isFixed = true
+				for _, r := range rules {
+					if err := r.apply(fixed); err != nil {
+						isFixed = false
+						i := slices.IndexFunc(fixed, func(s int) bool { return s == r.lhs })
+						j := slices.IndexFunc(fixed, func(s int) bool { return s == r.rhs })
+						fixed[i], fixed[j] = fixed[j], fixed[i]
+						break
+					}
+				}
+			}
+			mid := seq[len(seq)/2]
+			score += mid
+		}
+	}
+	return solver.Int(score), nil
+}
@@ -0,0 +1,15 @@
+package day05
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{})
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{})
+}
@@ -0,0 +1,123 @@
+package day06
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	c "github.com/0x28F4/aoc2024/utils/container/bytes"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+	"github.com/0x28F4/aoc2024/utils/set"
+)
+
+func init() {
+	solver.Register(6, Solver{})
+}
+
+type Solver struct{}
+
+func handleInput(r io.Reader) (c.Container, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return c.Container{}, err
+	}
+	return parse.Bytes(lines)
+}
+
+type pointWithDir struct {
+	point.Point
+	dir point.Direction
+}
+
+type guard struct {
+	pos        point.Point
+	dir        point.Direction
+	path       set.Set[point.Point]
+	beenBefore set.Set[pointWithDir]
+}
+
+func newGuard(pos point.Point, dir point.Direction) guard {
+	return guard{
+		pos:        pos,
+		dir:        dir,
+		path:       set.New[point.Point](),
+		beenBefore: set.New[pointWithDir](),
+	}
+}
+
+func (g guard) traverse(con c.Container) (isLoop bool) {
+	for {
+		g.path.Add(g.pos)
+		nxtPos := g.pos.Move(g.dir)
+		v, err := con.At(nxtPos)
+		if err != nil {
+			return false
+		}
+		if v == '#' {
+			g.dir = g.dir.TurnRight()
+			g.beenBefore.Add(pointWithDir{g.pos, g.dir})
+			continue
+		}
+		g.pos = nxtPos
+
+		pd := pointWithDir{g.pos, g.dir}
+		if g.beenBefore.Contains(pd) {
+			return true
+		}
+		g.beenBefore.Add(pointWithDir{g.pos, g.dir})
+	}
+}
+
+// patrol lets the guard walk the unmodified lab and returns the container,
+// the guard's starting position and the guard itself.
+func patrol(r io.Reader) (c.Container, point.Point, guard, error) {
+	con, err := handleInput(r)
+	if err != nil {
+		return c.Container{}, point.Point{}, guard{}, err
+	}
+
+	p, err := con.FindFirst('^')
+	if err != nil {
+		return c.Container{}, point.Point{}, guard{}, fmt.Errorf("guard: %w", err)
+	}
+	g := newGuard(p, point.Up)
+	utils.MustFalse(g.traverse(con))
+	return con, p, g, nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	_, _, g, err := patrol(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(g.path.Len()), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	con, p, g, err := patrol(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	withLoops := set.New[point.Point]()
+	for _, obstacle := range g.path.Items() {
+		if obstacle == p {
+			continue
+		}
+
+		// place the obstacle in the lab itself and take it away afterwards
+		prev, err := con.At(obstacle)
+		if err != nil {
+			return solver.Answer{}, fmt.Errorf("not able to place obstacle at %s: %w", obstacle, err)
+		}
+		utils.MustNil(con.Set(obstacle, '#'))
+		ng := newGuard(p, point.Up)
+		if ng.traverse(con) {
+			withLoops.Add(obstacle)
+		}
+		utils.MustNil(con.Set(obstacle, prev))
+	}
+	return solver.Int(len(withLoops)), nil
+}
@@ -0,0 +1,163 @@
+package day07
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"unsafe"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/perm"
+)
+
+func init() {
+	if s := unsafe.Sizeof(0); s != 8 {
+		panic(fmt.Sprintf("only works on 64bit systems, sizeof int: %d", s))
+	}
+	solver.Register(7, Solver{})
+}
+
+type Solver struct{}
+
+func solve(r io.Reader, symbols []string) (solver.Answer, error) {
+	calibrations, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	ops, err := lookup(symbols)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	score := 0
+	for _, cal := range calibrations {
+		if cal.isPossible(ops) {
+			score += cal.testValue
+		}
+	}
+	return solver.Int(score), nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	return solve(r, []string{"+", "*"})
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	return solve(r, []string{"+", "*", "||"})
+}
+
+type calibration struct {
+	items     []int
+	testValue int
+	// lastZero is the index of the last 0 in items, or -1. Results only grow
+	// after it.
+	lastZero int
+}
+
+func newCalibration(testValue int, items []int) calibration {
+	c := calibration{items: items, testValue: testValue, lastZero: -1}
+	for i, n := range items {
+		if n == 0 {
+			c.lastZero = i
+		}
+	}
+	return c
+}
+
+// isPossible works backwards from the test value, undoing one operator per
+// number from the right. Operators which can't be undone at some point
+// prune that whole branch, operators which can be undone from any acc end
+// the search as the rest of the equation doesn't matter anymore. Without an
+// inverse for every operator it falls back to trying all combinations.
+func (c calibration) isPossible(ops []Operator) bool {
+	inverters := make([]Inverter, 0, len(ops))
+	for _, op := range ops {
+		inv, ok := op.(Inverter)
+		if !ok {
+			return c.bruteForce(ops)
+		}
+		inverters = append(inverters, inv)
+	}
+
+	var reachable func(result int, items []int) bool
+	reachable = func(result int, items []int) bool {
+		last := len(items) - 1
+		if last == 0 {
+			return result == items[0]
+		}
+		for _, inv := range inverters {
+			switch acc, kind := inv.Invert(result, items[last]); kind {
+			case AnyInverse:
+				return true
+			case OneInverse:
+				if reachable(acc, items[:last]) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return reachable(c.testValue, c.items)
+}
+
+// bruteForce evaluates the equation left to right for every combination of
+// operators.
+func (c calibration) bruteForce(ops []Operator) bool {
+	prune := monotone(ops)
+	for ops := range perm.Product(len(c.items)-1, ops) {
+		if c.eval(ops, prune) {
+			return true
+		}
+	}
+	return false
+}
+
+// eval reports whether ops give the test value. With prune, which needs
+// Monotone operators, it stops once the result is too large.
+func (c calibration) eval(ops []Operator, prune bool) bool {
+	utils.MustEq(len(ops), len(c.items)-1)
+	res := c.items[0]
+	for i, op := range ops {
+		if prune && res > c.testValue && i >= c.lastZero {
+			// the remaining numbers are positive, so results only grow
+			return false
+		}
+		res = op.Apply(res, c.items[i+1])
+	}
+	return res == c.testValue
+}
+
+func handleInput(r io.Reader) ([]calibration, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []calibration
+	for _, line := range lines {
+		parts, err := line.Split(":", 2)
+		if err != nil {
+			return nil, err
+		}
+		testValue, err := parts[0].Int()
+		if err != nil {
+			return nil, err
+		}
+		items, err := parts[1].Ints()
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, parts[1].Errorf("expected at least one number")
+		}
+		// || has no meaning for negative numbers
+		if slices.Min(items) < 0 {
+			return nil, parts[1].Errorf("expected non-negative numbers")
+		}
+		ret = append(ret, newCalibration(testValue, items))
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,121 @@
+package day07
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver/solvertest"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/perm"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{})
+}
+
+func TestOperators(t *testing.T) {
+	ops, err := lookup([]string{"+", "*", "||"})
+	utils.MustNil(err)
+	for _, op := range ops {
+		for _, n := range []int{0, 1, 7, 10, 99, 100, 123} {
+			acc := 4711
+			res := op.Apply(acc, n)
+			got, inv := op.(Inverter).Invert(res, n)
+			if n == 0 && op == operators["*"] {
+				utils.MustEq(inv, AnyInverse)
+				continue
+			}
+			utils.MustEq(inv, OneInverse)
+			utils.MustEq(got, acc)
+		}
+	}
+
+	utils.MustEq(operators["||"].Apply(12, 345), 12345)
+	utils.MustEq(operators["||"].Apply(0, 5), 5)
+	_, inv := operators["||"].(Inverter).Invert(12345, 45)
+	utils.MustEq(inv, OneInverse)
+	_, inv = operators["||"].(Inverter).Invert(12345, 55)
+	utils.MustEq(inv, NoInverse)
+	_, inv = operators["*"].(Inverter).Invert(10, 3)
+	utils.MustEq(inv, NoInverse)
+	_, inv = operators["*"].(Inverter).Invert(10, 0)
+	utils.MustEq(inv, NoInverse)
+	_, inv = operators["+"].(Inverter).Invert(3, 10)
+	utils.MustEq(inv, NoInverse)
+
+	_, err = lookup([]string{"+", "-"})
+	utils.MustNotNil(err)
+}
+
+// TestBackwards cross checks the pruned backwards search and the forward
+// search against evaluating every combination from perm.Equal in full.
+func TestBackwards(t *testing.T) {
+	ops, err := lookup([]string{"+", "*", "||"})
+	utils.MustNil(err)
+	apply := func(items []int, combo []Operator) int {
+		res := items[0]
+		for i, op := range combo {
+			res = op.Apply(res, items[i+1])
+		}
+		return res
+	}
+
+	rng := rand.New(rand.NewSource(7))
+	for range 1000 {
+		items := make([]int, 1+rng.Intn(6))
+		for i := range items {
+			items[i] = rng.Intn(20)
+		}
+		combos := perm.Equal(len(items)-1, ops)
+		// pick a reachable test value half of the time
+		testValue := rng.Intn(1000)
+		if rng.Intn(2) == 0 {
+			testValue = apply(items, combos[rng.Intn(len(combos))])
+		}
+		c := newCalibration(testValue, items)
+
+		want := false
+		for _, combo := range combos {
+			if apply(items, combo) == testValue {
+				want = true
+				break
+			}
+		}
+		utils.MustEq(c.isPossible(ops), want)
+		utils.MustEq(c.bruteForce(ops), want)
+	}
+
+	// 5 * 3 * 0 shrinks back to the test value
+	utils.MustTrue(newCalibration(0, []int{5, 3, 0}).isPossible(ops))
+	utils.MustTrue(newCalibration(0, []int{5, 3, 0}).bruteForce(ops))
+}
+
+// sub is neither Monotone nor an Inverter, so it is only ever tried forwards
+// and without pruning.
+type sub struct{}
+
+func (sub) Apply(acc, n int) int { return acc - n }
+
+func TestNonMonotone(t *testing.T) {
+	ops := []Operator{operators["+"], sub{}}
+	utils.MustFalse(monotone(ops))
+	utils.MustTrue(newCalibration(5, []int{10, 5}).isPossible(ops))
+	utils.MustTrue(newCalibration(4, []int{10, 7, 1}).isPossible(ops))
+	utils.MustFalse(newCalibration(5, []int{10, 6}).isPossible(ops))
+
+	ops, err := lookup([]string{"+", "*", "||"})
+	utils.MustNil(err)
+	utils.MustTrue(monotone(ops))
+}
+
+func TestInput(t *testing.T) {
+	_, err := handleInput(strings.NewReader("0: 5 3 0\n"))
+	utils.MustNil(err)
+	_, err = handleInput(strings.NewReader("7: 5 -3\n"))
+	utils.MustNotNil(err)
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{})
+}
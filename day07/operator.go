@@ -0,0 +1,124 @@
+package day07
+
+import "fmt"
+
+// Operator combines the result so far with the next number of an equation.
+type Operator interface {
+	Apply(acc, n int) int
+}
+
+// Inverse tells how many values of acc give a result.
+type Inverse int
+
+const (
+	NoInverse  Inverse = iota // no acc gives the result
+	OneInverse                // exactly the returned acc does
+	AnyInverse                // every acc does, like for * 0 = 0
+)
+
+// Inverter is implemented by operators which can be undone. Invert returns
+// the acc for which Apply(acc, n) is result.
+type Inverter interface {
+	Invert(result, n int) (acc int, inv Inverse)
+}
+
+// Monotone is implemented by operators for which Apply(acc, n) >= acc holds
+// for non-negative acc and positive n. Only if all operators of an equation
+// are, a partial result above the test value can be given up on.
+type Monotone interface {
+	Monotone()
+}
+
+func monotone(ops []Operator) bool {
+	for _, op := range ops {
+		if _, ok := op.(Monotone); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func inverse(ok bool) Inverse {
+	if ok {
+		return OneInverse
+	}
+	return NoInverse
+}
+
+var operators = map[string]Operator{}
+
+// register makes op available under symbol.
+func register(symbol string, op Operator) {
+	if _, exists := operators[symbol]; exists {
+		panic(fmt.Sprintf("operator %s registered twice", symbol))
+	}
+	operators[symbol] = op
+}
+
+func lookup(symbols []string) ([]Operator, error) {
+	ops := make([]Operator, 0, len(symbols))
+	for _, s := range symbols {
+		op, exists := operators[s]
+		if !exists {
+			return nil, fmt.Errorf("unknown operator %s", s)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func init() {
+	register("+", add{})
+	register("*", mul{})
+	register("||", concat{})
+}
+
+type add struct{}
+
+func (add) Apply(acc, n int) int { return acc + n }
+
+func (add) Monotone() {}
+
+func (add) Invert(result, n int) (int, Inverse) {
+	return result - n, inverse(result >= n)
+}
+
+type mul struct{}
+
+func (mul) Apply(acc, n int) int { return acc * n }
+
+func (mul) Monotone() {}
+
+func (mul) Invert(result, n int) (int, Inverse) {
+	switch {
+	case n == 0 && result == 0:
+		return 0, AnyInverse
+	case n == 0 || result%n != 0:
+		return 0, NoInverse
+	}
+	return result / n, OneInverse
+}
+
+// concat appends the digits of n to acc, 12 || 345 = 12345.
+type concat struct{}
+
+func (concat) Apply(acc, n int) int { return acc*shift(n) + n }
+
+func (concat) Monotone() {}
+
+func (concat) Invert(result, n int) (int, Inverse) {
+	pow := shift(n)
+	if result < n || (result-n)%pow != 0 {
+		return 0, NoInverse
+	}
+	return (result - n) / pow, OneInverse
+}
+
+// shift returns the power of ten with one more digit than n.
+func shift(n int) int {
+	pow := 10
+	for pow <= n {
+		pow *= 10
+	}
+	return pow
+}
@@ -1,30 +1,34 @@
-package main
+package day08
 
 import (
-	"flag"
-	"fmt"
 	"io"
 	"iter"
-	"os"
-	"strings"
 
+	"github.com/0x28F4/aoc2024/solver"
 	"github.com/0x28F4/aoc2024/utils"
 	c "github.com/0x28F4/aoc2024/utils/container/string"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/perm"
 	"github.com/0x28F4/aoc2024/utils/point"
 	"github.com/0x28F4/aoc2024/utils/set"
 )
 
-var inputFile = flag.String("input", "example", "select input file")
+func init() {
+	solver.Register(8, Solver{})
+}
+
+type Solver struct{}
 
-var con c.Container
-var antennas = make(map[string][]point.Point)
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	con, antennas, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 
-func solve() {
-	handleInput()
 	antinodes := set.New[point.Point]()
 	for _, points := range antennas {
-		pairs := makePairs(points)
-		for _, pair := range pairs {
+		for a, b := range perm.Pairs(points) {
+			pair := pair{a, b}
 			for _, forward := range []bool{true, false} {
 				next, stop := iter.Pull(pair.shoot(forward))
 				p, _ := next()
@@ -35,13 +39,20 @@ func solve() {
 			}
 		}
 	}
-	fmt.Println("part1", len(antinodes))
+	return solver.Int(len(antinodes)), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	con, antennas, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 
-	antinodes = set.New[point.Point]()
+	antinodes := set.New[point.Point]()
 	for _, points := range antennas {
-		pairs := makePairs(points)
-		utils.MustTrue(len(pairs) > 0)
-		for _, pa := range pairs {
+		utils.MustTrue(perm.CountPairs(len(points)) > 0)
+		for a, b := range perm.Pairs(points) {
+			pa := pair{a, b}
 			antinodes.Add(pa.a, pa.b)
 			for _, forward := range []bool{true, false} {
 				next, stop := iter.Pull(pa.shoot(forward))
@@ -57,27 +68,22 @@ func solve() {
 			}
 		}
 	}
-	fmt.Println("part2", len(antinodes))
+	return solver.Int(len(antinodes)), nil
 }
 
-func main() {
-	flag.Parse()
-	solve()
-}
-
-func handleInput() {
-	file, err := os.Open(*inputFile)
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-	lines := strings.Split(string(bytes), "\n")
+func handleInput(r io.Reader) (c.Container, map[string][]point.Point, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return c.Container{}, nil, err
+	}
 
-	con = c.Container{
-		Lines: lines,
+	con, err := parse.StringGrid(lines)
+	if err != nil {
+		return c.Container{}, nil, err
 	}
+	antennas := make(map[string][]point.Point)
 
-	for y, line := range lines {
+	for y, line := range con.Lines {
 		for x, r := range line {
 			s := string(r)
 			if s == "." {
@@ -92,6 +98,7 @@ func handleInput() {
 			antennas[s] = sl
 		}
 	}
+	return con, antennas, nil
 }
 
 type pair struct {
@@ -99,16 +106,6 @@ type pair struct {
 	b point.Point
 }
 
-func makePairs(points []point.Point) []pair {
-	ret := make([]pair, 0)
-	for i := 0; i < len(points)-1; i++ {
-		for j := i + 1; j < len(points); j++ {
-			ret = append(ret, pair{points[i], points[j]})
-		}
-	}
-	return ret
-}
-
 func (p pair) shoot(forward bool) iter.Seq[point.Point] {
 	return func(yield func(point.Point) bool) {
 		cur := p.a
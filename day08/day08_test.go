@@ -1,9 +1,10 @@
-package main
+package day08
 
 import (
 	"iter"
 	"testing"
 
+	"github.com/0x28F4/aoc2024/solver/solvertest"
 	"github.com/0x28F4/aoc2024/utils"
 	"github.com/0x28F4/aoc2024/utils/point"
 )
@@ -23,3 +24,11 @@ func TestAntinodes(t *testing.T) {
 	utils.MustEq(next(), point.Point{X: -3, Y: -3})
 	stop()
 }
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{})
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{})
+}
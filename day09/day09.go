@@ -1,22 +1,20 @@
-package main
+package day09
 
 import (
-	"flag"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 
-	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
 )
 
-var inputFile = flag.String("input", "example4", "select input file")
-
-func main() {
-	flag.Parse()
-	solve()
+func init() {
+	solver.Register(9, Solver{})
 }
 
+type Solver struct{}
+
 type memory struct {
 	data   []*int
 	cursor int
@@ -81,20 +79,23 @@ func (m *memory) score() int {
 	return score
 }
 
-func handleInput() *memory {
+func handleInput(r io.Reader) (*memory, error) {
 	mem := newMemory()
-	file, err := os.Open(*inputFile)
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-
-	input := string(bytes)
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("expected the disk map on a single line, got %d lines", len(lines))
+	}
+	digits, err := lines[0].Trim().Digits()
+	if err != nil {
+		return nil, err
+	}
 
 	isBlock := true
 	id := 0
-	for _, r := range input {
-		i := utils.MustInt(string(r))
+	for _, i := range digits {
 		if isBlock {
 			for range i {
 				v := id
@@ -109,11 +110,14 @@ func handleInput() *memory {
 		isBlock = !isBlock
 	}
 
-	return mem
+	return mem, nil
 }
 
-func solve() {
-	mem := handleInput()
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	mem, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 	for i := len(mem.data) - 1; i >= 0; i-- {
 		d := mem.data[i]
 		if d == nil {
@@ -132,9 +136,14 @@ func solve() {
 		}
 	}
 
-	fmt.Println("part 1", mem.score())
+	return solver.Int(mem.score()), nil
+}
 
-	mem = handleInput()
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	mem, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
 	cursor := len(mem.data) - 1
 	for {
 		if cursor <= 0 {
@@ -175,5 +184,5 @@ func solve() {
 		}
 	}
 
-	fmt.Println("part 2", mem.score())
+	return solver.Int(mem.score()), nil
 }
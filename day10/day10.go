@@ -0,0 +1,136 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"log"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+	"github.com/0x28F4/aoc2024/utils/search"
+	"github.com/0x28F4/aoc2024/utils/set"
+)
+
+func init() {
+	solver.Register(10, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	m, err := handleInput(string(bytes))
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	score := 0
+	for _, s := range solve(m) {
+		score += s
+	}
+	return solver.Int(score), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	m, err := handleInput(string(bytes))
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(solveB(m)), nil
+}
+
+func solve(m Map) []int {
+	startingPoints := m.FindAll(value{height: 0})
+	var scores []int
+	for _, start := range startingPoints {
+		score := len(find9s(m, start))
+		scores = append(scores, score)
+	}
+	return scores
+}
+
+func solveB(m Map) (score int) {
+	startingPoints := m.FindAll(value{height: 0})
+	for _, start := range startingPoints {
+		score += findDistinctPaths(m, start)
+	}
+	return
+}
+
+// uphill yields the neighbors of pos which are exactly one higher.
+func (m Map) uphill(pos point.Point) iter.Seq[point.Point] {
+	return func(yield func(point.Point) bool) {
+		v, err := m.At(pos)
+		if err != nil {
+			log.Panicf("[uphill] error when access %s | err = %s", pos, err)
+		}
+		for _, nb := range pos.Neighbors4() {
+			if nbv, err := m.At(nb); err == nil && nbv.height-1 == v.height {
+				if !yield(nb) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func find9s(m Map, start point.Point) set.Set[point.Point] {
+	targets := set.New[point.Point]()
+	for pos := range search.BFS(start, m.uphill, nil).Dist {
+		if v, _ := m.At(pos); v.height == 9 {
+			targets.Add(pos)
+		}
+	}
+	return targets
+}
+
+// findDistinctPaths counts the trails from start to any 9. Trails only go
+// uphill one step at a time, so every trail to a 9 is a shortest path to it.
+func findDistinctPaths(m Map, start point.Point) (paths int) {
+	r := search.BFSAll(start, m.uphill, nil)
+	for pos := range r.Dist {
+		if v, _ := m.At(pos); v.height == 9 {
+			paths += r.Count(pos)
+		}
+	}
+	return paths
+}
+
+type value struct {
+	height      int
+	notRelevant bool
+}
+
+func (v value) String() string {
+	if v.notRelevant {
+		return "."
+	}
+	return fmt.Sprintf("%d", v.height)
+}
+
+type Map struct {
+	grid.Grid[value]
+}
+
+func handleInput(raw string) (Map, error) {
+	con, err := parse.Grid(parse.Lines(raw), func(r rune) (value, error) {
+		if r == '.' {
+			return value{notRelevant: true}, nil
+		}
+		height, err := parse.Int(string(r))
+		return value{height: height}, err
+	})
+	return Map{con}, err
+}
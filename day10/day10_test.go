@@ -1,9 +1,10 @@
-package main
+package day10
 
 import (
 	"slices"
 	"testing"
 
+	"github.com/0x28F4/aoc2024/solver/solvertest"
 	"github.com/0x28F4/aoc2024/utils"
 )
 
@@ -46,7 +47,8 @@ func TestTrails(t *testing.T) {
 	utils.MustEq(len(trailScores), len(maps))
 	utils.MustEq(len(trailScores), len(trailSums))
 	for i := 0; i < len(maps); i++ {
-		m := handleInput(maps[i])
+		m, err := handleInput(maps[i])
+		utils.MustNil(err)
 		scores := trailScores[i]
 		actualScores := solve(m)
 
@@ -59,3 +61,11 @@ func TestTrails(t *testing.T) {
 		utils.MustEq(trailSums[i], sum)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{})
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{})
+}
@@ -0,0 +1,68 @@
+package day11
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/counter"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func init() {
+	solver.Register(11, Solver{})
+}
+
+type Solver struct{}
+
+func solve(r io.Reader, steps int) (solver.Answer, error) {
+	stones, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	// the order of the stones doesn't matter, only how many show each number
+	c := counter.FromSlice(stones)
+	for range steps {
+		c = c.Transform(blink)
+	}
+	return solver.Int(c.Total()), nil
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	return solve(r, 25)
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	return solve(r, 75)
+}
+
+// blink returns the stones a stone engraved with v turns into.
+func blink(v int) []int {
+	if v == 0 {
+		return []int{1}
+	}
+	digits := strconv.Itoa(v)
+	if len(digits)%2 == 0 {
+		lhs := utils.MustInt(digits[0 : len(digits)/2])
+		rhs := utils.MustInt(digits[len(digits)/2:])
+		return []int{lhs, rhs}
+	}
+	return []int{v * 2024}
+}
+
+func handleInput(r io.Reader) ([]int, error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("expected the stones on a single line, got %d lines", len(lines))
+	}
+	return lines[0].Ints()
+}
+
+// If the stone is engraved with a number that has an even number of digits, it is replaced by two stones. The left half of the digits are engraved on the new left stone, and the right half of the digits are engraved on the new right stone. (The new numbers don't keep extra leading zeroes: 1000 would become stones 10 and 0.)
+// If none of the other rules apply, the stone is replaced by a new stone; the old stone's number multiplied by 2024 is engraved on the new stone.
@@ -0,0 +1,68 @@
+package day12
+
+import (
+	"io"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/dsu"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/region"
+)
+
+func init() {
+	solver.Register(12, Solver{})
+}
+
+type Solver struct{}
+
+type garden struct {
+	region.Region
+	kind byte
+}
+
+// plant splits the farm into gardens of connected plots of the same kind.
+func plant(con grid.Grid[byte]) []garden {
+	var gardens []garden
+	for _, plots := range dsu.Regions(con).Components() {
+		kind, err := con.At(plots[0])
+		utils.MustNil(err)
+		gardens = append(gardens, garden{region.New(plots...), kind})
+	}
+	return gardens
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	con, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	price := 0
+	for _, g := range plant(con) {
+		price += g.Area() * g.Perimeter()
+	}
+	return solver.Int(price), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	con, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	price := 0
+	for _, g := range plant(con) {
+		price += g.Area() * g.Sides()
+	}
+	return solver.Int(price), nil
+}
+
+func handleInput(r io.Reader) (grid.Grid[byte], error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return grid.Grid[byte]{}, err
+	}
+	return parse.Grid(lines, parse.Byte)
+}
@@ -0,0 +1,122 @@
+package day13
+
+import (
+	"io"
+	"regexp"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func init() {
+	solver.Register(13, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	machines, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	solution := 0
+	for _, ma := range machines {
+		solution += ma.solve()
+	}
+	return solver.Int(solution), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	machines, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	solution := 0
+	for _, ma := range machines {
+		ma.price = ma.price.Add(point.Point{X: 10000000000000, Y: 10000000000000})
+		solution += ma.solve()
+	}
+	return solver.Int(solution), nil
+}
+
+type machine struct {
+	aButton point.Point
+	bButton point.Point
+	price   point.Point
+}
+
+func (ma machine) solve() int {
+	B := ma.aButton
+	A := ma.bButton
+	P := ma.price
+	den := A.Y*B.X - A.X*B.Y
+	if den == 0 {
+		panic("div by zero")
+	}
+
+	num := P.Y*(B.X-3*A.X) - P.X*(B.Y-3*A.Y)
+
+	if num%den == 0 {
+		c := num / den
+
+		mNum := (P.X - A.X*c)
+		mDen := (B.X - 3*A.X)
+		// divide 0 by 0 🤷
+		if mNum == 0 && mDen == 0 {
+			return c
+		}
+		if mDen == 0 {
+			return 0
+		}
+
+		if (P.X-A.X*c)%(B.X-3*A.X) != 0 {
+			return 0
+		}
+
+		m := (P.X - A.X*c) / (B.X - 3*A.X)
+		n := c - 3*m
+
+		if m < 0 {
+			return 0
+		}
+		if n < 0 {
+			return 0
+		}
+
+		return c
+	}
+
+	return 0
+}
+
+var buttonRe = regexp.MustCompile(`^Button .: X\+(\d+), Y\+(\d+)$`)
+var priceRe = regexp.MustCompile(`^Prize: X=(\d+), Y=(\d+)$`)
+
+func handleInput(r io.Reader) (machines []machine, err error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lines := range sections {
+		if len(lines) != 3 {
+			return nil, lines[0].Errorf("expected 2 buttons and a prize, got %d lines", len(lines))
+		}
+
+		var ma machine
+		if err := lines[0].Match(buttonRe, &ma.aButton); err != nil {
+			return nil, err
+		}
+		if err := lines[1].Match(buttonRe, &ma.bButton); err != nil {
+			return nil, err
+		}
+		if err := lines[2].Match(priceRe, &ma.price); err != nil {
+			return nil, err
+		}
+		machines = append(machines, ma)
+	}
+	return machines, nil
+}
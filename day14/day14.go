@@ -0,0 +1,180 @@
+package day14
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/0x28F4/aoc2024/solver"
+	container "github.com/0x28F4/aoc2024/utils/container/bytes"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func init() {
+	solver.Register(14, Solver{bathroom: defaultBathroom})
+}
+
+// defaultBathroom is the size of the real input's bathroom, the example's is
+// passed as params width=11 and height=7.
+var defaultBathroom = point.Point{X: 101, Y: 103}
+
+type Solver struct {
+	bathroom point.Point
+}
+
+func (Solver) ParamNames() []string {
+	return []string{"width", "height"}
+}
+
+func (s Solver) WithParams(p solver.Params) (solver.Solver, error) {
+	var err error
+	if s.bathroom.X, err = p.Int("width", s.bathroom.X); err != nil {
+		return nil, err
+	}
+	if s.bathroom.Y, err = p.Int("height", s.bathroom.Y); err != nil {
+		return nil, err
+	}
+	if s.bathroom.X <= 0 || s.bathroom.Y <= 0 {
+		return nil, fmt.Errorf("expected a positive width and height, got %dx%d", s.bathroom.X, s.bathroom.Y)
+	}
+	return s, nil
+}
+
+type quad struct {
+	origin    point.Point
+	dimension point.Point
+}
+
+func (q quad) inside(p point.Point) bool {
+	if p.X < q.origin.X {
+		return false
+	}
+	if p.Y < q.origin.Y {
+		return false
+	}
+
+	one := point.Point{X: 1, Y: 1}
+	br := q.origin.Add(q.dimension).Sub(one)
+	if p.X > br.X {
+		return false
+	}
+	if p.Y > br.Y {
+		return false
+	}
+
+	return true
+}
+
+func (s Solver) Part1(r io.Reader) (solver.Answer, error) {
+	robots, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	bathroom := s.bathroom
+
+	for range 100 {
+		for _, r := range robots {
+			r.update(bathroom)
+		}
+	}
+
+	half := bathroom.Div(point.Point{X: 2, Y: 2})
+	quads := []quad{
+		{
+			origin:    point.Point{X: 0, Y: 0},
+			dimension: half,
+		},
+		{
+			origin:    point.Point{X: half.X + 1, Y: 0},
+			dimension: half,
+		},
+		{
+			origin:    point.Point{X: 0, Y: half.Y + 1},
+			dimension: half,
+		},
+		{
+			origin:    point.Point{X: half.X + 1, Y: half.Y + 1},
+			dimension: half,
+		},
+	}
+
+	var quadSums []int
+	for _, q := range quads {
+		i := 0
+		for _, r := range robots {
+			isInside := q.inside(r.pos)
+			if isInside {
+				i++
+			}
+		}
+		quadSums = append(quadSums, i)
+	}
+	score := 1
+	for _, s := range quadSums {
+		score *= s
+	}
+	return solver.Int(score), nil
+}
+
+var errNoTree = errors.New("no christmas tree found")
+
+func (s Solver) Part2(r io.Reader) (solver.Answer, error) {
+	robots, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	bathroom := s.bathroom
+
+	m := container.Blank(bathroom.X, bathroom.Y, ' ')
+	rx := regexp.MustCompile(`########`)
+	for t := range 100000 {
+		m.Fill(' ')
+		for _, r := range robots {
+			r.update(bathroom)
+			m.Set(r.pos, '#')
+		}
+		if m.Re(rx) {
+			return solver.Int(t + 1), nil
+		}
+	}
+	return solver.Answer{}, errNoTree
+}
+
+type robot struct {
+	pos point.Point
+	vel point.Point
+}
+
+func (r *robot) String() string {
+	return fmt.Sprintf("p=%d,%d v=%d,%d", r.pos.X, r.pos.Y, r.vel.X, r.vel.Y)
+}
+
+func (r *robot) update(bathroom point.Point) {
+	r.pos = r.pos.Add(r.vel).Mod(bathroom)
+}
+
+func handleInput(r io.Reader) (ret []*robot, err error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		// p=0,4 v=3,-3
+		nums, err := line.ExtractInts()
+		if err != nil {
+			return nil, err
+		}
+		if len(nums) != 4 {
+			return nil, line.Errorf("expected position and velocity, got %d numbers", len(nums))
+		}
+		ret = append(ret, &robot{
+			pos: point.Point{X: nums[0], Y: nums[1]},
+			vel: point.Point{X: nums[2], Y: nums[3]},
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,26 @@
+package day14
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/solver/solvertest"
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{bathroom: defaultBathroom})
+}
+
+func TestParams(t *testing.T) {
+	_, err := solver.Configure(Solver{bathroom: defaultBathroom}, solver.Params{"width": "11", "height": "7"})
+	utils.MustNil(err)
+	_, err = solver.Configure(Solver{bathroom: defaultBathroom}, solver.Params{"width": "0"})
+	utils.MustNotNil(err)
+	_, err = solver.Configure(Solver{bathroom: defaultBathroom}, solver.Params{"height": "-7"})
+	utils.MustNotNil(err)
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{bathroom: defaultBathroom})
+}
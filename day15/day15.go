@@ -0,0 +1,33 @@
+package day15
+
+import (
+	"io"
+
+	"github.com/0x28F4/aoc2024/day15/part1"
+	"github.com/0x28F4/aoc2024/day15/part2"
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+func init() {
+	solver.Register(15, Solver{})
+}
+
+// Solver dispatches to the two warehouse simulations, the wide warehouse of
+// part 2 differs too much from part 1 to share one implementation.
+type Solver struct{}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	score, err := part1.Solve(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(score), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	score, err := part2.Solve(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(score), nil
+}
@@ -1,32 +1,18 @@
-package main
+package part1
 
 import (
-	"flag"
+	"errors"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 
 	"github.com/0x28F4/aoc2024/utils"
 	container "github.com/0x28F4/aoc2024/utils/container/string"
+	"github.com/0x28F4/aoc2024/utils/parse"
 	"github.com/0x28F4/aoc2024/utils/point"
 	"github.com/0x28F4/aoc2024/utils/set"
 )
 
-var inputFile = flag.String("input", "example2", "select input file")
-
-var cross = map[string]point.DirFn{
-	"^": point.UP,
-	"v": point.DOWN,
-	"<": point.LEFT,
-	">": point.RIGHT,
-}
-
-func main() {
-	flag.Parse()
-	solve()
-}
-
 type tx func(b *box)
 
 type box struct {
@@ -34,14 +20,9 @@ type box struct {
 	tx  tx
 }
 
-func (b *box) push(dir string) point.Point {
-	if len(dir) != 1 {
-		panic("length of dir is not equal to 1")
-	}
-	dirfn, exists := cross[dir]
-	utils.MustTrue(exists)
-	b.tx = tx(func(b *box) { b.pos = dirfn(b.pos) })
-	nxt := dirfn(b.pos)
+func (b *box) push(dir point.Direction) point.Point {
+	b.tx = tx(func(b *box) { b.pos = b.pos.Move(dir) })
+	nxt := b.pos.Move(dir)
 	return nxt
 }
 
@@ -61,7 +42,7 @@ type simulation struct {
 	walls set.Set[point.Point]
 	robot *box
 	boxes []*box
-	inst  string
+	inst  []point.Direction
 
 	dimension point.Point
 }
@@ -93,11 +74,11 @@ func (s *simulation) score() (score int) {
 }
 
 func (s *simulation) update() (done bool) {
-	var dir string
-	dir, s.inst = utils.StringPopLeft(s.inst)
-	if dir == "" {
+	if len(s.inst) == 0 {
 		return true
 	}
+	dir := s.inst[0]
+	s.inst = s.inst[1:]
 
 	rollback := false
 	cur := s.robot.push(dir)
@@ -142,40 +123,53 @@ func (s *simulation) update() (done bool) {
 	return
 }
 
-func solve() {
-	sim := parseMap()
+// Solve runs the warehouse robot until all instructions are consumed and
+// returns the sum of all boxes' GPS coordinates.
+func Solve(r io.Reader) (int, error) {
+	sim, err := parseMap(r)
+	if err != nil {
+		return 0, err
+	}
 	for !sim.update() {
 	}
-	sim.print()
-	fmt.Println("part 1", sim.score())
+	return sim.score(), nil
 }
 
-func handleInput() (string, string) {
-	file, err := os.Open(*inputFile)
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-
-	input := string(bytes)
-
-	parts := strings.Split(input, "\n\n")
-	utils.MustLen(parts, 2)
+func handleInput(r io.Reader) ([]parse.Line, []point.Direction, error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("expected the map and the instructions, got %d sections", len(sections))
+	}
 
-	return parts[0], parts[1]
+	var instr []point.Direction
+	for _, line := range sections[1] {
+		for i, r := range line.Text {
+			dir, err := point.ParseDirection(r)
+			if err != nil {
+				return nil, nil, &parse.Error{Line: line.No, Col: line.Col + i, Err: err}
+			}
+			instr = append(instr, dir)
+		}
+	}
+	return sections[0], instr, nil
 }
 
-func parseMap() (ret *simulation) {
-	rawMap, instr := handleInput()
+func parseMap(r io.Reader) (ret *simulation, err error) {
+	lines, instr, err := handleInput(r)
+	if err != nil {
+		return nil, err
+	}
 	ret = &simulation{
 		walls: set.New[point.Point](),
+		inst:  instr,
 	}
-	ret.inst = strings.ReplaceAll(instr, "\n", "")
 
-	lines := strings.Split(rawMap, "\n")
-	ret.dimension = point.Point{X: len(lines[0]), Y: len(lines)}
+	ret.dimension = point.Point{X: len(lines[0].Text), Y: len(lines)}
 	for y, line := range lines {
-		for x, r := range line {
+		for x, r := range line.Text {
 			p := point.Point{X: x, Y: y}
 			v := string(r)
 			if v == "@" {
@@ -193,9 +187,14 @@ func parseMap() (ret *simulation) {
 				continue
 			}
 
-			utils.MustEq(v, ".")
+			if v != "." {
+				return nil, &parse.Error{Line: line.No, Col: x + 1, Err: fmt.Errorf("unknown tile %q", v)}
+			}
 		}
 	}
+	if ret.robot == nil {
+		return nil, errors.New("no robot found")
+	}
 
-	return
+	return ret, nil
 }
@@ -1,32 +1,17 @@
-package main
+package part2
 
 import (
-	"flag"
+	"errors"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 
-	"github.com/0x28F4/aoc2024/utils"
 	container "github.com/0x28F4/aoc2024/utils/container/string"
+	"github.com/0x28F4/aoc2024/utils/parse"
 	"github.com/0x28F4/aoc2024/utils/point"
 	"github.com/0x28F4/aoc2024/utils/set"
 )
 
-var inputFile = flag.String("input", "example2", "select input file")
-
-var cross = map[string]point.DirFn{
-	"^": point.UP,
-	"v": point.DOWN,
-	"<": point.LEFT,
-	">": point.RIGHT,
-}
-
-func main() {
-	flag.Parse()
-	solve()
-}
-
 type tx func(b *box)
 
 type box struct {
@@ -34,14 +19,9 @@ type box struct {
 	tx  tx
 }
 
-func (b *box) push(dir string) (point.Point, point.Point) {
-	if len(dir) != 1 {
-		panic("length of dir is not equal to 1")
-	}
-	dirfn, exists := cross[dir]
-	utils.MustTrue(exists)
-	b.tx = tx(func(b *box) { b.pos = dirfn(b.pos) })
-	nxt := dirfn(b.pos)
+func (b *box) push(dir point.Direction) (point.Point, point.Point) {
+	b.tx = tx(func(b *box) { b.pos = b.pos.Move(dir) })
+	nxt := b.pos.Move(dir)
 	return nxt, nxt.Add(R)
 }
 
@@ -67,7 +47,7 @@ type simulation struct {
 	walls set.Set[point.Point]
 	robot *box
 	boxes []*box
-	inst  string
+	inst  []point.Direction
 
 	dimension point.Point
 }
@@ -104,7 +84,7 @@ var R = point.Point{X: 1, Y: 0}
 
 type force struct {
 	at  point.Point
-	dir string
+	dir point.Direction
 }
 
 func (f force) apply(s *simulation) bool {
@@ -128,11 +108,11 @@ func (f force) apply(s *simulation) bool {
 }
 
 func (s *simulation) update() (done bool) {
-	var dir string
-	dir, s.inst = utils.StringPopLeft(s.inst)
-	if dir == "" {
+	if len(s.inst) == 0 {
 		return true
 	}
+	dir := s.inst[0]
+	s.inst = s.inst[1:]
 
 	cur, _ := s.robot.push(dir)
 	f := force{
@@ -153,40 +133,53 @@ func (s *simulation) update() (done bool) {
 	return
 }
 
-func solve() {
-	sim := parseMap()
+// Solve runs the warehouse robot until all instructions are consumed and
+// returns the sum of all boxes' GPS coordinates.
+func Solve(r io.Reader) (int, error) {
+	sim, err := parseMap(r)
+	if err != nil {
+		return 0, err
+	}
 	for !sim.update() {
 	}
-	sim.print()
-	fmt.Println("part 2", sim.score())
+	return sim.score(), nil
 }
 
-func handleInput() (string, string) {
-	file, err := os.Open(*inputFile)
-	utils.HandleError(err)
-
-	bytes, err := io.ReadAll(file)
-	utils.HandleError(err)
-
-	input := string(bytes)
-
-	parts := strings.Split(input, "\n\n")
-	utils.MustLen(parts, 2)
+func handleInput(r io.Reader) ([]parse.Line, []point.Direction, error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("expected the map and the instructions, got %d sections", len(sections))
+	}
 
-	return parts[0], parts[1]
+	var instr []point.Direction
+	for _, line := range sections[1] {
+		for i, r := range line.Text {
+			dir, err := point.ParseDirection(r)
+			if err != nil {
+				return nil, nil, &parse.Error{Line: line.No, Col: line.Col + i, Err: err}
+			}
+			instr = append(instr, dir)
+		}
+	}
+	return sections[0], instr, nil
 }
 
-func parseMap() (ret *simulation) {
-	rawMap, instr := handleInput()
+func parseMap(r io.Reader) (ret *simulation, err error) {
+	lines, instr, err := handleInput(r)
+	if err != nil {
+		return nil, err
+	}
 	ret = &simulation{
 		walls: set.New[point.Point](),
+		inst:  instr,
 	}
-	ret.inst = strings.ReplaceAll(instr, "\n", "")
 
-	lines := strings.Split(rawMap, "\n")
-	ret.dimension = point.Point{X: len(lines[0]) * 2, Y: len(lines)}
+	ret.dimension = point.Point{X: len(lines[0].Text) * 2, Y: len(lines)}
 	for y, line := range lines {
-		for x, r := range line {
+		for x, r := range line.Text {
 			v := string(r)
 			a := point.Point{X: x * 2, Y: y}
 			b := point.Point{X: x*2 + 1, Y: y}
@@ -207,9 +200,14 @@ func parseMap() (ret *simulation) {
 				continue
 			}
 
-			utils.MustEq(v, ".")
+			if v != "." {
+				return nil, &parse.Error{Line: line.No, Col: x + 1, Err: fmt.Errorf("unknown tile %q", v)}
+			}
 		}
 	}
+	if ret.robot == nil {
+		return nil, errors.New("no robot found")
+	}
 
-	return
+	return ret, nil
 }
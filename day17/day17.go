@@ -0,0 +1,169 @@
+package day17
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func init() {
+	solver.Register(17, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	reg, prog, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	vm := NewVM(reg, prog)
+	vm.MaxSteps = runSteps
+	output, err := vm.Output()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	var values []string
+	for _, v := range output {
+		values = append(values, strconv.Itoa(v))
+	}
+	return solver.String(strings.Join(values, ",")), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	reg, prog, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	a, err := findQuine(reg, prog)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(a), nil
+}
+
+// runSteps bounds a single run of the input, which may well loop forever.
+const runSteps = 1 << 24
+
+var errNoQuine = errors.New("no value of register A makes the program output itself")
+
+// quineSteps bounds every run while searching, a guess for A may well make
+// the program loop forever.
+const quineSteps = 1 << 16
+
+// findQuine returns the lowest value of register A for which prog outputs
+// itself. Like the puzzle inputs, prog has to print once per loop and shift
+// A right by three bits until it is zero, so the last output only depends
+// on the top three bits of A. A is built from there three bits at a time,
+// trying the lowest bits first.
+func findQuine(reg Registers, prog []int) (int, error) {
+	var search func(a, n int) (int, bool)
+	search = func(a, n int) (int, bool) {
+		for bits := range 8 {
+			next := a<<3 | bits
+			reg.A = next
+			if !outputs(reg, prog, prog[len(prog)-n:]) {
+				continue
+			}
+			if n == len(prog) {
+				return next, true
+			}
+			if found, ok := search(next, n+1); ok {
+				return found, true
+			}
+		}
+		return 0, false
+	}
+
+	if len(prog) == 0 {
+		return 0, nil
+	}
+	a, ok := search(0, 1)
+	if !ok {
+		return 0, errNoQuine
+	}
+	return a, nil
+}
+
+// outputs runs prog until it halts and reports if it printed exactly want.
+// It stops at the first wrong output, or if the program fails.
+func outputs(reg Registers, prog, want []int) bool {
+	vm := NewVM(reg, prog)
+	vm.MaxSteps = quineSteps
+	n := 0
+	for !vm.Halted() {
+		v, emitted, err := vm.Step()
+		if err != nil {
+			return false
+		}
+		if !emitted {
+			continue
+		}
+		if n == len(want) || v != want[n] {
+			return false
+		}
+		n++
+	}
+	return n == len(want)
+}
+
+var registerRe = regexp.MustCompile(`^Register ([ABC]): (\d+)$`)
+
+func handleInput(r io.Reader) (reg Registers, prog []int, err error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return Registers{}, nil, err
+	}
+	if len(sections) != 2 || len(sections[0]) != 3 || len(sections[1]) != 1 {
+		return Registers{}, nil, errors.New("expected 3 registers, an empty line and the program")
+	}
+
+	targets := []*int{&reg.A, &reg.B, &reg.C}
+	for i, l := range sections[0] {
+		var register struct {
+			Name  string
+			Value int
+		}
+		if err := l.Match(registerRe, &register); err != nil {
+			return Registers{}, nil, err
+		}
+		if register.Name != string(rune('A'+i)) {
+			return Registers{}, nil, l.Errorf("expected register %c, got %s", 'A'+i, register.Name)
+		}
+		*targets[i] = register.Value
+	}
+
+	parts, err := sections[1][0].Split(": ", 2)
+	if err != nil {
+		return Registers{}, nil, err
+	}
+	if prog, err = parts[1].IntList(","); err != nil {
+		return Registers{}, nil, err
+	}
+	for _, v := range prog {
+		if v < 0 || v > 7 {
+			return Registers{}, nil, parts[1].Errorf("expected 3-bit numbers, got %d", v)
+		}
+	}
+	if len(prog)%2 != 0 {
+		return Registers{}, nil, parts[1].Errorf("%w", errOddProgram)
+	}
+	return reg, prog, nil
+}
+
+// DisassembleInput writes the program of a puzzle input to w as
+// pseudo-code, see Disassemble.
+func DisassembleInput(r io.Reader, w io.Writer) error {
+	_, prog, err := handleInput(r)
+	if err != nil {
+		return err
+	}
+	return Disassemble(w, prog)
+}
@@ -0,0 +1,142 @@
+package day17
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver/solvertest"
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{})
+}
+
+func TestVM(t *testing.T) {
+	vm := NewVM(Registers{C: 9}, []int{2, 6})
+	_, err := vm.Output()
+	utils.MustNil(err)
+	utils.MustEq(vm.B, 1)
+
+	out, err := NewVM(Registers{A: 10}, []int{5, 0, 5, 1, 5, 4}).Output()
+	utils.MustNil(err)
+	utils.MustSliceEq(out, []int{0, 1, 2})
+
+	vm = NewVM(Registers{A: 2024}, []int{0, 1, 5, 4, 3, 0})
+	out, err = vm.Output()
+	utils.MustNil(err)
+	utils.MustSliceEq(out, []int{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0})
+	utils.MustEq(vm.A, 0)
+
+	vm = NewVM(Registers{B: 29}, []int{1, 7})
+	_, err = vm.Output()
+	utils.MustNil(err)
+	utils.MustEq(vm.B, 26)
+
+	vm = NewVM(Registers{B: 2024, C: 43690}, []int{4, 0})
+	_, err = vm.Output()
+	utils.MustNil(err)
+	utils.MustEq(vm.B, 44354)
+
+	_, err = NewVM(Registers{}, []int{0, 7}).Output()
+	utils.MustNotNil(err)
+
+	vm = NewVM(Registers{A: 1}, []int{3, 0})
+	vm.MaxSteps = 100
+	_, err = vm.Output()
+	utils.MustEq(err, ErrStepLimit)
+
+	_, err = NewVM(Registers{B: -1}, []int{0, 5}).Output()
+	utils.MustEq(err.Error(), "0: negative shift by -1")
+	out, err = NewVM(Registers{A: -3}, []int{5, 4}).Output()
+	utils.MustNil(err)
+	utils.MustSliceEq(out, []int{5})
+}
+
+func TestRun(t *testing.T) {
+	vm := NewVM(Registers{A: 729}, []int{0, 1, 5, 4, 3, 0})
+	var steps []State
+	vm.Trace = func(s State) { steps = append(steps, s) }
+
+	out := make(chan int)
+	errc := make(chan error, 1)
+	go func() { errc <- vm.Run(out) }()
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	utils.MustNil(<-errc)
+	utils.MustSliceEq(got, []int{4, 6, 3, 5, 6, 3, 5, 2, 1, 0})
+	utils.MustTrue(vm.Halted())
+
+	// three instructions for each of the ten loops
+	utils.MustLen(steps, 30)
+	utils.MustEq(steps[0].IP, 0)
+	utils.MustEq(steps[0].A, 729)
+	utils.MustEq(steps[1].Op, Out)
+	utils.MustEq(steps[1].A, 364)
+	utils.MustEq(steps[2].String(), " 4: jnz 0   A=364 B=0 C=0")
+}
+
+// the program the old hard-coded solution was derived from
+var input = []int{2, 4, 1, 5, 7, 5, 0, 3, 4, 1, 1, 6, 5, 5, 3, 0}
+
+func TestDisassemble(t *testing.T) {
+	_, prog, err := handleInput(strings.NewReader("Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 2,4,1,5,7,5,0,3,4,1,1,6,5,5,3,0\n"))
+	utils.MustNil(err)
+	utils.MustSliceEq(prog, input)
+
+	var sb strings.Builder
+	utils.MustNil(Disassemble(&sb, prog))
+	utils.MustEq(sb.String(), ` 0: B = A % 8
+ 2: B = B ^ 5
+ 4: C = A >> B
+ 6: A = A >> 3
+ 8: B = B ^ C
+10: B = B ^ 6
+12: out B % 8
+14: if A != 0 goto 0
+`)
+
+	// the reserved operand only fails when running
+	sb.Reset()
+	utils.MustNil(Disassemble(&sb, []int{5, 7, 5, 4}))
+	utils.MustEq(sb.String(), " 0: out <reserved 7> % 8\n 2: out A % 8\n")
+
+	_, _, err = handleInput(strings.NewReader("Register A: 0\nRegister C: 0\nRegister B: 0\n\nProgram: 0,3\n"))
+	utils.MustNotNil(err)
+	_, _, err = handleInput(strings.NewReader("Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 0,8\n"))
+	utils.MustNotNil(err)
+	_, _, err = handleInput(strings.NewReader("Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 0,3,5\n"))
+	utils.MustTrue(errors.Is(err, errOddProgram))
+	utils.MustEq(Disassemble(&sb, []int{0, 3, 5}), errOddProgram)
+
+	sb.Reset()
+	utils.MustNil(DisassembleInput(strings.NewReader("Register A: 0\nRegister B: 0\nRegister C: 0\n\nProgram: 5,4,3,0\n"), &sb))
+	utils.MustEq(sb.String(), " 0: out A % 8\n 2: if A != 0 goto 0\n")
+}
+
+func TestPart1Loops(t *testing.T) {
+	_, err := Solver{}.Part1(strings.NewReader("Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 3,0\n"))
+	utils.MustEq(err, ErrStepLimit)
+}
+
+func TestFindQuine(t *testing.T) {
+	a, err := findQuine(Registers{}, input)
+	utils.MustNil(err)
+	out, err := NewVM(Registers{A: a}, input).Output()
+	utils.MustNil(err)
+	utils.MustSliceEq(out, input)
+
+	// never shifts A, so the output can't grow
+	_, err = findQuine(Registers{}, []int{5, 4, 3, 0})
+	utils.MustEq(err, errNoQuine)
+	// loops forever without printing anything
+	_, err = findQuine(Registers{}, []int{3, 0})
+	utils.MustEq(err, errNoQuine)
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{})
+}
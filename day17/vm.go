@@ -0,0 +1,243 @@
+package day17
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Registers of the 3-bit computer.
+type Registers struct {
+	A, B, C int
+}
+
+func (r Registers) String() string {
+	return fmt.Sprintf("A=%d B=%d C=%d", r.A, r.B, r.C)
+}
+
+type Opcode int
+
+const (
+	Adv Opcode = iota // A = A >> combo
+	Bxl               // B = B ^ literal
+	Bst               // B = combo % 8
+	Jnz               // if A != 0 jump to literal
+	Bxc               // B = B ^ C, the operand is ignored
+	Out               // output combo % 8
+	Bdv               // B = A >> combo
+	Cdv               // C = A >> combo
+)
+
+var opcodeNames = [...]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+func (op Opcode) String() string {
+	if op < 0 || int(op) >= len(opcodeNames) {
+		return fmt.Sprintf("Opcode(%d)", int(op))
+	}
+	return opcodeNames[op]
+}
+
+var (
+	errReservedOperand = errors.New("combo operand 7 is reserved")
+	errOddProgram      = errors.New("expected opcode and operand pairs, got an odd number of values")
+)
+
+// Instruction is an opcode together with its operand.
+type Instruction struct {
+	Op      Opcode
+	Operand int
+}
+
+// combo names a combo operand, the reserved 7 gets a placeholder so that
+// listings can go on past it.
+func combo(operand int) string {
+	switch {
+	case operand < 4:
+		return fmt.Sprint(operand)
+	case operand < 7:
+		return string(rune('A' + operand - 4))
+	}
+	return fmt.Sprintf("<reserved %d>", operand)
+}
+
+// Pseudo returns the instruction as a line of pseudo-code.
+func (in Instruction) Pseudo() string {
+	switch in.Op {
+	case Adv:
+		return "A = A >> " + combo(in.Operand)
+	case Bxl:
+		return fmt.Sprintf("B = B ^ %d", in.Operand)
+	case Bst:
+		return fmt.Sprintf("B = %s %% 8", combo(in.Operand))
+	case Jnz:
+		return fmt.Sprintf("if A != 0 goto %d", in.Operand)
+	case Bxc:
+		return "B = B ^ C"
+	case Out:
+		return fmt.Sprintf("out %s %% 8", combo(in.Operand))
+	case Bdv:
+		return "B = A >> " + combo(in.Operand)
+	case Cdv:
+		return "C = A >> " + combo(in.Operand)
+	}
+	return fmt.Sprintf("<unknown opcode %d>", int(in.Op))
+}
+
+func (in Instruction) String() string {
+	return fmt.Sprintf("%s %d", in.Op, in.Operand)
+}
+
+// Disassemble writes prog to w as one line of pseudo-code per instruction,
+// prefixed with its address. Invalid operands are listed as placeholders,
+// only running the program fails on them.
+func Disassemble(w io.Writer, prog []int) error {
+	if len(prog)%2 != 0 {
+		return errOddProgram
+	}
+	for ip := 0; ip+1 < len(prog); ip += 2 {
+		line := Instruction{Opcode(prog[ip]), prog[ip+1]}.Pseudo()
+		if _, err := fmt.Fprintf(w, "%2d: %s\n", ip, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// State is passed to VM.Trace before an instruction is executed.
+type State struct {
+	IP int
+	Instruction
+	Registers
+}
+
+func (s State) String() string {
+	return fmt.Sprintf("%2d: %-7s %s", s.IP, s.Instruction, s.Registers)
+}
+
+// VM runs a program on the 3-bit computer. It halts once the instruction
+// pointer moves past the last instruction.
+type VM struct {
+	Registers
+	IP      int
+	Program []int
+	// Trace, if set, sees every instruction before it is executed.
+	Trace func(State)
+	// MaxSteps, if positive, limits how many instructions are executed
+	// before Step fails with ErrStepLimit.
+	MaxSteps int
+	steps    int
+}
+
+var ErrStepLimit = errors.New("step limit reached")
+
+func NewVM(reg Registers, prog []int) *VM {
+	return &VM{Registers: reg, Program: prog}
+}
+
+func (vm *VM) Halted() bool {
+	return vm.IP < 0 || vm.IP+1 >= len(vm.Program)
+}
+
+func (vm *VM) combo(operand int) (int, error) {
+	switch operand {
+	case 4:
+		return vm.A, nil
+	case 5:
+		return vm.B, nil
+	case 6:
+		return vm.C, nil
+	case 7:
+		return 0, errReservedOperand
+	}
+	return operand, nil
+}
+
+// Step executes a single instruction, out is only valid if emitted is true.
+func (vm *VM) Step() (out int, emitted bool, err error) {
+	if vm.Halted() {
+		return 0, false, errors.New("vm is halted")
+	}
+	if vm.MaxSteps > 0 && vm.steps >= vm.MaxSteps {
+		return 0, false, ErrStepLimit
+	}
+	vm.steps++
+	in := Instruction{Opcode(vm.Program[vm.IP]), vm.Program[vm.IP+1]}
+	if vm.Trace != nil {
+		vm.Trace(State{vm.IP, in, vm.Registers})
+	}
+
+	// only bxl and jnz take literal operands, bxc ignores its operand
+	var operand int
+	switch in.Op {
+	case Bxl, Jnz, Bxc:
+		operand = in.Operand
+	default:
+		if operand, err = vm.combo(in.Operand); err != nil {
+			return 0, false, fmt.Errorf("%d: %w", vm.IP, err)
+		}
+	}
+	switch in.Op {
+	case Adv, Bdv, Cdv:
+		if operand < 0 {
+			return 0, false, fmt.Errorf("%d: negative shift by %d", vm.IP, operand)
+		}
+	}
+
+	vm.IP += 2
+	switch in.Op {
+	case Adv:
+		vm.A >>= operand
+	case Bxl:
+		vm.B ^= operand
+	case Bst:
+		// & 7 is % 8 which stays in 0..7 for negative registers
+		vm.B = operand & 7
+	case Jnz:
+		if vm.A != 0 {
+			vm.IP = operand
+		}
+	case Bxc:
+		vm.B ^= vm.C
+	case Out:
+		return operand & 7, true, nil
+	case Bdv:
+		vm.B = vm.A >> operand
+	case Cdv:
+		vm.C = vm.A >> operand
+	default:
+		return 0, false, fmt.Errorf("%d: unknown opcode %d", vm.IP-2, in.Op)
+	}
+	return 0, false, nil
+}
+
+// Run executes the program until it halts and sends every output to out,
+// which is closed when Run returns.
+func (vm *VM) Run(out chan<- int) error {
+	defer close(out)
+	for !vm.Halted() {
+		v, emitted, err := vm.Step()
+		if err != nil {
+			return err
+		}
+		if emitted {
+			out <- v
+		}
+	}
+	return nil
+}
+
+// Output executes the program until it halts and returns everything it
+// printed.
+func (vm *VM) Output() ([]int, error) {
+	var output []int
+	for !vm.Halted() {
+		v, emitted, err := vm.Step()
+		if err != nil {
+			return output, err
+		}
+		if emitted {
+			output = append(output, v)
+		}
+	}
+	return output, nil
+}
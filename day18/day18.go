@@ -0,0 +1,163 @@
+package day18
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/dsu"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+	"github.com/0x28F4/aoc2024/utils/search"
+)
+
+func init() {
+	solver.Register(18, Solver{size: defaultSize, fallen: defaultFallen})
+}
+
+// the real input's memory space is 71x71 tiles and part 1 looks at the first
+// 1024 bytes, the example passes size=7 and fallen=12 as params
+const (
+	defaultSize   = 71
+	defaultFallen = 1024
+)
+
+type Solver struct {
+	size   int
+	fallen int
+}
+
+func (Solver) ParamNames() []string {
+	return []string{"size", "fallen"}
+}
+
+func (s Solver) WithParams(p solver.Params) (solver.Solver, error) {
+	var err error
+	if s.size, err = p.Int("size", s.size); err != nil {
+		return nil, err
+	}
+	if s.fallen, err = p.Int("fallen", s.fallen); err != nil {
+		return nil, err
+	}
+	if s.size <= 0 || s.fallen <= 0 {
+		return nil, fmt.Errorf("expected a positive size and fallen, got size=%d fallen=%d", s.size, s.fallen)
+	}
+	return s, nil
+}
+
+var errNoPath = errors.New("no path to the exit")
+
+// memory holds for every tile the index of the byte falling onto it, tiles
+// no byte falls onto hold the number of bytes.
+type memory struct {
+	grid.Grid[int]
+	start, exit point.Point
+}
+
+func newMemory(size int, coordinates []point.Point) (memory, error) {
+	m := memory{
+		Grid: grid.Filled(size, size, len(coordinates)),
+		exit: point.Point{X: size - 1, Y: size - 1},
+	}
+	for i, c := range coordinates {
+		if !m.InBounds(c) {
+			return memory{}, fmt.Errorf("byte %d falls at %d,%d, outside of the memory space", i+1, c.X, c.Y)
+		}
+		if first, _ := m.At(c); first < i {
+			continue
+		}
+		utils.MustNil(m.Set(c, i))
+	}
+	return m, nil
+}
+
+// shortestPath returns the number of steps to the exit after the first
+// fallen bytes are corrupted, ok is false if the exit can't be reached.
+func (m memory) shortestPath(fallen int) (steps int, ok bool) {
+	corrupted := search.Grid[int]{Grid: m.Grid, Wall: func(i int) bool { return i < fallen }}
+	r := search.BFS(m.start, corrupted.Neighbors, func(p point.Point) bool { return p == m.exit })
+	return r.Dist[m.exit], r.Found
+}
+
+func (s Solver) Part1(r io.Reader) (solver.Answer, error) {
+	coordinates, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	m, err := newMemory(s.size, coordinates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	steps, ok := m.shortestPath(s.fallen)
+	if !ok {
+		return solver.Answer{}, errNoPath
+	}
+	return solver.Int(steps), nil
+}
+
+func (s Solver) Part2(r io.Reader) (solver.Answer, error) {
+	coordinates, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	m, err := newMemory(s.size, coordinates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	// bytes only ever fall, so go backwards: start with all of them fallen and
+	// clear them in reverse until start and exit are connected again
+	d := dsu.New[point.Point]()
+	free := func(p point.Point, fallen int) {
+		d.Add(p)
+		for _, nb := range p.Neighbors4() {
+			if i, err := m.At(nb); err == nil && i >= fallen {
+				d.Union(p, nb)
+			}
+		}
+	}
+	for p, i := range m.All() {
+		if i == len(coordinates) {
+			free(p, len(coordinates))
+		}
+	}
+	if d.Connected(m.start, m.exit) {
+		return solver.Answer{}, errors.New("the exit is never cut off")
+	}
+
+	for i := len(coordinates) - 1; i >= 0; i-- {
+		c := coordinates[i]
+		if first, _ := m.At(c); first != i {
+			// an earlier byte fell on the same tile
+			continue
+		}
+		free(c, i)
+		if d.Connected(m.start, m.exit) {
+			return solver.String(fmt.Sprintf("%d,%d", c.X, c.Y)), nil
+		}
+	}
+	return solver.Answer{}, errNoPath
+}
+
+func handleInput(r io.Reader) (coordinates []point.Point, err error) {
+	lines, err := parse.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range lines {
+		xy, err := c.IntList(",")
+		if err != nil {
+			return nil, err
+		}
+		if len(xy) != 2 {
+			return nil, c.Errorf("expected a coordinate like 5,4, got %q", c.Text)
+		}
+		coordinates = append(coordinates, point.Point{X: xy[0], Y: xy[1]})
+	}
+	return coordinates, nil
+}
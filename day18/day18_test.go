@@ -0,0 +1,26 @@
+package day18
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/solver/solvertest"
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, Solver{size: defaultSize, fallen: defaultFallen})
+}
+
+func TestParams(t *testing.T) {
+	_, err := solver.Configure(Solver{size: defaultSize, fallen: defaultFallen}, solver.Params{"size": "7", "fallen": "12"})
+	utils.MustNil(err)
+	_, err = solver.Configure(Solver{size: defaultSize, fallen: defaultFallen}, solver.Params{"size": "0"})
+	utils.MustNotNil(err)
+	_, err = solver.Configure(Solver{size: defaultSize, fallen: defaultFallen}, solver.Params{"fallen": "-5"})
+	utils.MustNotNil(err)
+}
+
+func BenchmarkSolver(b *testing.B) {
+	solvertest.Bench(b, Solver{size: defaultSize, fallen: defaultFallen})
+}
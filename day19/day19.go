@@ -0,0 +1,79 @@
+package day19
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/memo"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+func init() {
+	solver.Register(19, Solver{})
+}
+
+type Solver struct{}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	towels, designs, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	arrangements := newArrangements(towels)
+	solution := 0
+	for _, des := range designs {
+		if arrangements.Call(des) > 0 {
+			solution++
+		}
+	}
+	return solver.Int(solution), nil
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	towels, designs, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	arrangements := newArrangements(towels)
+	solution := 0
+	for _, des := range designs {
+		solution += arrangements.Call(des)
+	}
+	return solver.Int(solution), nil
+}
+
+// newArrangements counts the ways a design can be made of towels. The cache
+// is shared by all designs of one input.
+func newArrangements(towels []string) *memo.Func[string, int] {
+	return memo.New(func(recurse func(string) int, rem string) (ret int) {
+		if rem == "" {
+			return 1
+		}
+		for _, towel := range towels {
+			if strings.HasPrefix(rem, towel) {
+				ret += recurse(rem[len(towel):])
+			}
+		}
+		return ret
+	})
+}
+
+func handleInput(r io.Reader) (towels []string, designs []string, err error) {
+	sections, err := parse.ReadSections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 || len(sections[0]) != 1 {
+		return nil, nil, errors.New("expected the towel patterns, an empty line and the designs")
+	}
+
+	towels = sections[0][0].List(",")
+	for _, des := range sections[1] {
+		designs = append(designs, des.Text)
+	}
+	return towels, designs, nil
+}
@@ -0,0 +1,28 @@
+package solver
+
+import "strconv"
+
+// Answer is the result of a single puzzle part. Most puzzles are answered
+// with a number, some (like day17) with a string.
+type Answer struct {
+	isInt bool
+	i     int
+	s     string
+}
+
+func Int(i int) Answer {
+	return Answer{isInt: true, i: i, s: strconv.Itoa(i)}
+}
+
+func String(s string) Answer {
+	return Answer{s: s}
+}
+
+// Int returns the numeric value of the answer, ok is false for string answers.
+func (a Answer) Int() (i int, ok bool) {
+	return a.i, a.isInt
+}
+
+func (a Answer) String() string {
+	return a.s
+}
@@ -0,0 +1,92 @@
+package solver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// AnswersFile is the name of the file in a day directory which holds the
+// accepted answers of that day.
+const AnswersFile = "answers.json"
+
+// PartAnswers holds the accepted answers for one input, an empty string
+// means the part has not been solved yet. Params are the ones the answers
+// were recorded with.
+type PartAnswers struct {
+	Part1  string `json:"part1,omitempty"`
+	Part2  string `json:"part2,omitempty"`
+	Params Params `json:"params,omitempty"`
+}
+
+func (p PartAnswers) Get(part int) (string, bool) {
+	switch part {
+	case 1:
+		return p.Part1, p.Part1 != ""
+	case 2:
+		return p.Part2, p.Part2 != ""
+	}
+	return "", false
+}
+
+// Answers maps input file names, relative to the day directory, to the
+// accepted answers for that input.
+type Answers map[string]PartAnswers
+
+// LoadAnswers reads the answers file of a day directory. A missing file is
+// not an error, it yields empty answers.
+func LoadAnswers(dir string) (Answers, error) {
+	bytes, err := os.ReadFile(filepath.Join(dir, AnswersFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Answers{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	answers := Answers{}
+	if err := json.Unmarshal(bytes, &answers); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Join(dir, AnswersFile), err)
+	}
+	return answers, nil
+}
+
+func (a Answers) Save(dir string) error {
+	bytes, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, AnswersFile), append(bytes, '\n'), 0o644)
+}
+
+func (a Answers) Record(input string, params Params, part int, answer Answer) error {
+	p, exists := a[input]
+	if exists && p.Params.String() != params.String() {
+		return fmt.Errorf("answers for %s were recorded with params %q, not %q", input, p.Params, params)
+	}
+	p.Params = params
+	switch part {
+	case 1:
+		p.Part1 = answer.String()
+	case 2:
+		p.Part2 = answer.String()
+	default:
+		return fmt.Errorf("unknown part %d", part)
+	}
+	a[input] = p
+	return nil
+}
+
+// Inputs returns the recorded input names in a stable order.
+func (a Answers) Inputs() []string {
+	inputs := make([]string, 0, len(a))
+	for input := range a {
+		inputs = append(inputs, input)
+	}
+	slices.Sort(inputs)
+	return inputs
+}
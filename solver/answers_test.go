@@ -0,0 +1,34 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestAnswers(t *testing.T) {
+	dir := t.TempDir()
+
+	answers, err := LoadAnswers(dir)
+	utils.MustNil(err)
+	utils.MustEq(len(answers), 0)
+
+	utils.MustNil(answers.Record("input", nil, 1, Int(42)))
+	utils.MustNil(answers.Record("input", nil, 2, String("4,2")))
+	utils.MustNil(answers.Record("example", Params{"size": "7"}, 1, Int(7)))
+	utils.MustNotNil(answers.Record("example", Params{"size": "7"}, 3, Int(7)))
+	utils.MustNotNil(answers.Record("example", nil, 2, Int(7)))
+	utils.MustNil(answers.Save(dir))
+
+	loaded, err := LoadAnswers(dir)
+	utils.MustNil(err)
+	utils.MustSliceEq(loaded.Inputs(), []string{"example", "input"})
+
+	v, ok := loaded["input"].Get(2)
+	utils.MustTrue(ok)
+	utils.MustEq(v, "4,2")
+
+	_, ok = loaded["example"].Get(2)
+	utils.MustFalse(ok)
+	utils.MustEq(loaded["example"].Params["size"], "7")
+}
@@ -0,0 +1,107 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExamplesGlob matches the example files of a day, relative to its directory.
+const ExamplesGlob = "examples/*.txt"
+
+// exampleSeparator ends the header of an example file.
+const exampleSeparator = "---"
+
+// Example is a puzzle example with its expected answers. Example files start
+// with a header of "part1: <answer>" and "part2: <answer>" lines and any
+// number of "param: <name>=<value>" lines, terminated by a "---" line,
+// followed by the puzzle input:
+//
+//	part1: 12
+//	param: width=11
+//	param: height=7
+//	---
+//	p=0,4 v=3,-3
+//
+// A part without a header line has no known answer for this example. The
+// newline ending the file is not part of the input.
+type Example struct {
+	Name   string
+	Want   PartAnswers
+	Params Params
+	Input  string
+	// Offset is the number of lines before the input, including the
+	// separator.
+	Offset int
+}
+
+// IsExample reports whether data looks like an example file rather than a
+// plain puzzle input, which never contains a separator line.
+func IsExample(data []byte) bool {
+	return strings.Contains("\n"+string(data), "\n"+exampleSeparator+"\n")
+}
+
+func ParseExample(name string, r io.Reader) (Example, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return Example{}, err
+	}
+
+	// leading newline so that a file starting with the separator has an empty header
+	header, input, found := strings.Cut("\n"+string(bytes), "\n"+exampleSeparator+"\n")
+	if !found {
+		return Example{}, fmt.Errorf("%s: missing %q after header", name, exampleSeparator)
+	}
+
+	ex := Example{Name: name, Input: strings.TrimSuffix(input, "\n"), Offset: strings.Count(header, "\n") + 1}
+	for i, line := range strings.Split(header, "\n")[1:] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return Example{}, fmt.Errorf("%s:%d: expected \"key: value\", got %q", name, i+1, line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "part1":
+			ex.Want.Part1 = value
+		case "part2":
+			ex.Want.Part2 = value
+		case "param":
+			if err := ex.Params.Set(value); err != nil {
+				return Example{}, fmt.Errorf("%s:%d: %w", name, i+1, err)
+			}
+		default:
+			return Example{}, fmt.Errorf("%s:%d: unknown header %q", name, i+1, key)
+		}
+	}
+	return ex, nil
+}
+
+// LoadExamples reads all example files below dir.
+func LoadExamples(dir string) ([]Example, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, ExamplesGlob))
+	if err != nil {
+		return nil, err
+	}
+
+	var examples []Example
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		ex, err := ParseExample(filepath.Base(path), file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, ex)
+	}
+	return examples, nil
+}
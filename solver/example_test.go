@@ -0,0 +1,42 @@
+package solver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestParseExample(t *testing.T) {
+	ex, err := ParseExample("example.txt", strings.NewReader("part1: 11\npart2: 4,2\n---\na\n\nb\n"))
+	utils.MustNil(err)
+	utils.MustEq(ex.Want.Part1, "11")
+	utils.MustEq(ex.Want.Part2, "4,2")
+	utils.MustEq(ex.Input, "a\n\nb")
+	utils.MustEq(ex.Offset, 3)
+
+	ex, err = ParseExample("example.txt", strings.NewReader("param: width=11\nparam: height = 7\n---\nx"))
+	utils.MustNil(err)
+	utils.MustEq(ex.Params.String(), "height=7 width=11")
+
+	_, err = ParseExample("example.txt", strings.NewReader("param: width\n---\nx"))
+	utils.MustNotNil(err)
+
+	ex, err = ParseExample("example.txt", strings.NewReader("---\nx"))
+	utils.MustNil(err)
+	_, ok := ex.Want.Get(1)
+	utils.MustFalse(ok)
+	utils.MustEq(ex.Input, "x")
+	utils.MustEq(ex.Offset, 1)
+
+	_, err = ParseExample("example.txt", strings.NewReader("part1: 11\nx"))
+	utils.MustNotNil(err)
+
+	_, err = ParseExample("example.txt", strings.NewReader("part3: 11\n---\nx"))
+	utils.MustNotNil(err)
+
+	utils.MustTrue(IsExample([]byte("part1: 11\n---\nx")))
+	utils.MustTrue(IsExample([]byte("---\nx")))
+	utils.MustFalse(IsExample([]byte("1 2\n3 4\n")))
+	utils.MustFalse(IsExample([]byte("a---\nx")))
+}
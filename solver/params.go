@@ -0,0 +1,88 @@
+package solver
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Params are named puzzle constants that differ between the examples and the
+// real input, like the size of day14's bathroom. Solvers read them in
+// WithParams and fall back to the real input's values for missing ones.
+type Params map[string]string
+
+// ParseParam splits "name=value".
+func ParseParam(s string) (name, value string, err error) {
+	name, value, found := strings.Cut(s, "=")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		return "", "", fmt.Errorf("expected param like name=value, got %q", s)
+	}
+	return name, strings.TrimSpace(value), nil
+}
+
+// Int returns the param name as a number or fallback if it is not set.
+func (p Params) Int(name string, fallback int) (int, error) {
+	v, exists := p[name]
+	if !exists {
+		return fallback, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("param %s: invalid number %q", name, v)
+	}
+	return i, nil
+}
+
+// String formats p as space separated name=value pairs in a stable order.
+func (p Params) String() string {
+	var pairs []string
+	for _, name := range slices.Sorted(maps.Keys(p)) {
+		pairs = append(pairs, name+"="+p[name])
+	}
+	return strings.Join(pairs, " ")
+}
+
+// Set implements flag.Value, so --param can be repeated.
+func (p *Params) Set(s string) error {
+	name, value, err := ParseParam(s)
+	if err != nil {
+		return err
+	}
+	if *p == nil {
+		*p = make(Params)
+	}
+	(*p)[name] = value
+	return nil
+}
+
+// Configurable is implemented by solvers that take params.
+type Configurable interface {
+	Solver
+	// ParamNames lists the params WithParams reads.
+	ParamNames() []string
+	// WithParams returns a copy of the solver configured with p.
+	WithParams(p Params) (Solver, error)
+}
+
+// Configure applies p to s. Params the solver doesn't know are rejected, so
+// a typo doesn't go unnoticed.
+func Configure(s Solver, p Params) (Solver, error) {
+	c, ok := s.(Configurable)
+	if !ok {
+		if len(p) > 0 {
+			return nil, fmt.Errorf("solver takes no params, got %s", p)
+		}
+		return s, nil
+	}
+
+	known := c.ParamNames()
+	for _, name := range slices.Sorted(maps.Keys(p)) {
+		if !slices.Contains(known, name) {
+			return nil, fmt.Errorf("unknown param %s, expected one of %s", name, strings.Join(known, ", "))
+		}
+	}
+	return c.WithParams(p)
+}
@@ -0,0 +1,56 @@
+package solver
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+type sizeSolver struct {
+	size int
+}
+
+func (s sizeSolver) Part1(r io.Reader) (Answer, error) { return Int(s.size), nil }
+func (s sizeSolver) Part2(r io.Reader) (Answer, error) { return Int(s.size * 2), nil }
+
+func (s sizeSolver) ParamNames() []string { return []string{"size"} }
+
+func (s sizeSolver) WithParams(p Params) (Solver, error) {
+	size, err := p.Int("size", s.size)
+	if err != nil {
+		return nil, err
+	}
+	return sizeSolver{size}, nil
+}
+
+func TestParams(t *testing.T) {
+	var p Params
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&p, "param", "")
+	utils.MustNil(fs.Parse([]string{"--param", "size=7", "--param", "a = b"}))
+	utils.MustEq(p.String(), "a=b size=7")
+
+	_, err := Configure(sizeSolver{71}, p)
+	utils.MustEq(err.Error(), "unknown param a, expected one of size")
+	delete(p, "a")
+
+	s, err := Configure(sizeSolver{71}, p)
+	utils.MustNil(err)
+	answer, err := s.Part1(nil)
+	utils.MustNil(err)
+	utils.MustEq(answer, Int(7))
+
+	s, err = Configure(sizeSolver{71}, nil)
+	utils.MustNil(err)
+	answer, err = s.Part2(nil)
+	utils.MustNil(err)
+	utils.MustEq(answer, Int(142))
+
+	_, err = Configure(sizeSolver{71}, Params{"size": "x"})
+	utils.MustNotNil(err)
+
+	_, _, err = ParseParam("=7")
+	utils.MustNotNil(err)
+}
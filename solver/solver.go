@@ -0,0 +1,55 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Solver solves both parts of a single puzzle day.
+type Solver interface {
+	Part1(r io.Reader) (Answer, error)
+	Part2(r io.Reader) (Answer, error)
+}
+
+var registry = make(map[int]Solver)
+
+// Register makes a solver available to the runner. It is meant to be called
+// from the init function of a day package and panics on duplicate days.
+func Register(day int, s Solver) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("solver for day %d already registered", day))
+	}
+	registry[day] = s
+}
+
+func Get(day int) (Solver, bool) {
+	s, ok := registry[day]
+	return s, ok
+}
+
+// Days returns all registered days in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	slices.Sort(days)
+	return days
+}
+
+// Dir returns the directory of a day relative to the repository root.
+func Dir(day int) string {
+	return fmt.Sprintf("day%02d", day)
+}
+
+// Part runs part 1 or 2 of s.
+func Part(s Solver, part int, r io.Reader) (Answer, error) {
+	switch part {
+	case 1:
+		return s.Part1(r)
+	case 2:
+		return s.Part2(r)
+	}
+	return Answer{}, fmt.Errorf("unknown part %d", part)
+}
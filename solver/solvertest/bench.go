@@ -0,0 +1,56 @@
+package solvertest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// BenchPart runs part of s against input b.N times.
+func BenchPart(b *testing.B, s solver.Solver, part int, input []byte) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := solver.Part(s, part, bytes.NewReader(input)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Bench benchmarks both parts of s against the puzzle input of the package
+// under test, or against the examples if there is no input yet.
+func Bench(b *testing.B, s solver.Solver) {
+	input, err := os.ReadFile("input")
+	if err == nil && len(input) > 0 {
+		for _, part := range []int{1, 2} {
+			b.Run(fmt.Sprintf("input/part%d", part), func(b *testing.B) {
+				BenchPart(b, s, part, input)
+			})
+		}
+		return
+	}
+
+	examples, err := solver.LoadExamples(".")
+	if err != nil {
+		b.Fatal(err)
+	}
+	if len(examples) == 0 {
+		b.Skip("no input and no examples")
+	}
+	for _, ex := range examples {
+		configured, err := solver.Configure(s, ex.Params)
+		if err != nil {
+			b.Fatalf("%s: %s", ex.Name, err)
+		}
+		for _, part := range []int{1, 2} {
+			if _, ok := ex.Want.Get(part); !ok {
+				continue
+			}
+			b.Run(fmt.Sprintf("%s/part%d", ex.Name, part), func(b *testing.B) {
+				BenchPart(b, configured, part, []byte(ex.Input))
+			})
+		}
+	}
+}
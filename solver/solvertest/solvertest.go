@@ -0,0 +1,49 @@
+// Package solvertest runs a day's solver against the examples stored next to
+// it, see solver.Example for the file format.
+package solvertest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/solver"
+)
+
+// Run checks s against every example in the examples directory of the
+// package under test, go test runs tests inside the package directory.
+func Run(t *testing.T, s solver.Solver) {
+	t.Helper()
+
+	examples, err := solver.LoadExamples(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(examples) == 0 {
+		t.Fatalf("no examples found matching %s", solver.ExamplesGlob)
+	}
+
+	for _, ex := range examples {
+		configured, err := solver.Configure(s, ex.Params)
+		if err != nil {
+			t.Fatalf("%s: %s", ex.Name, err)
+		}
+
+		for _, part := range []int{1, 2} {
+			want, ok := ex.Want.Get(part)
+			if !ok {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s/part%d", ex.Name, part), func(t *testing.T) {
+				got, err := solver.Part(configured, part, strings.NewReader(ex.Input))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.String() != want {
+					t.Errorf("got %s, want %s", got, want)
+				}
+			})
+		}
+	}
+}
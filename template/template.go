@@ -0,0 +1,44 @@
+package template
+
+import (
+	"errors"
+	"io"
+
+	"github.com/0x28F4/aoc2024/solver"
+	"github.com/0x28F4/aoc2024/utils/parse"
+)
+
+// day is replaced by `make day`
+const day = 0
+
+func init() {
+	solver.Register(day, Solver{})
+}
+
+type Solver struct{}
+
+var errNotImplemented = errors.New("not implemented")
+
+func handleInput(r io.Reader) ([]parse.Line, error) {
+	return parse.Read(r)
+}
+
+func (Solver) Part1(r io.Reader) (solver.Answer, error) {
+	lines, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	_ = lines
+
+	return solver.Answer{}, errNotImplemented
+}
+
+func (Solver) Part2(r io.Reader) (solver.Answer, error) {
+	lines, err := handleInput(r)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	_ = lines
+
+	return solver.Answer{}, errNotImplemented
+}
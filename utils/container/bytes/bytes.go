@@ -0,0 +1,71 @@
+// Package container is a grid of characters stored as bytes. Unlike the
+// string container, writing a cell doesn't rebuild its whole line.
+package container
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+)
+
+type Container struct {
+	grid.Grid[byte]
+}
+
+// New copies lines into a container, all lines need to be of the same length.
+func New(lines []string) (Container, error) {
+	if len(lines) == 0 {
+		return Container{}, nil
+	}
+	g := grid.New[byte](len(lines[0]), len(lines))
+	for y, line := range lines {
+		if len(line) != g.Width() {
+			return Container{}, fmt.Errorf("line %d has width %d, expected %d", y, len(line), g.Width())
+		}
+		copy(g.Row(y), line)
+	}
+	return Container{g}, nil
+}
+
+// Blank returns a container with every cell set to fill.
+func Blank(width, height int, fill byte) Container {
+	return Container{grid.Filled(width, height, fill)}
+}
+
+// Line returns row y as string.
+func (c Container) Line(y int) string {
+	return string(c.Row(y))
+}
+
+func (c Container) String() string {
+	var b bytes.Buffer
+	for y := range c.Height() {
+		b.Write(c.Row(y))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (c Container) Print() {
+	fmt.Print(c.String())
+}
+
+// Re reports whether any line matches r.
+func (c Container) Re(r *regexp.Regexp) bool {
+	for y := range c.Height() {
+		if r.Match(c.Row(y)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Container) Clone() Container {
+	return Container{c.Grid.Clone()}
+}
+
+func (c Container) Padded(b byte) Container {
+	return Container{c.Grid.Padded(b)}
+}
@@ -0,0 +1,33 @@
+package container
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func TestContainer(t *testing.T) {
+	c, err := New([]string{"..#", ".^."})
+	utils.MustNil(err)
+
+	p, err := c.FindFirst('^')
+	utils.MustNil(err)
+	utils.MustEq(p, point.Point{X: 1, Y: 1})
+
+	clone := c.Clone()
+	utils.MustNil(c.Set(point.Point{X: 0, Y: 0}, '#'))
+	utils.MustEq(c.String(), "#.#\n.^.\n")
+	utils.MustEq(clone.Line(0), "..#")
+
+	utils.MustTrue(c.Re(regexp.MustCompile(`^#\.#$`)))
+	utils.MustFalse(clone.Re(regexp.MustCompile(`##`)))
+
+	c.Fill(' ')
+	utils.MustEq(c.String(), "   \n   \n")
+	utils.MustEq(c.Padded('~').Line(1), "~   ~")
+
+	_, err = New([]string{"..", "."})
+	utils.MustNotNil(err)
+}
@@ -46,10 +46,10 @@ func (c Container[T]) At(p point.Point) (T, error) {
 	if y < 0 {
 		return *new(T), ErrOutOfBounds
 	}
-	if x >= len(c.Rows[0]) {
+	if y >= len(c.Rows) {
 		return *new(T), ErrOutOfBounds
 	}
-	if y >= len(c.Rows) {
+	if x >= len(c.Rows[y]) {
 		return *new(T), ErrOutOfBounds
 	}
 	return c.Rows[y][x], nil
@@ -60,7 +60,7 @@ func (c Container[T]) Set(p point.Point, v T) error {
 		return err
 	}
 
-	c.Rows[p.Y] = slices.Insert(c.Rows[p.Y], p.X, v)
+	c.Rows[p.Y][p.X] = v
 	return nil
 }
 
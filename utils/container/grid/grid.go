@@ -0,0 +1,160 @@
+// Package grid is a dense, rectangular grid stored row by row in a single
+// slice, so looking up or changing a cell is a multiplication away.
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+var ErrOutOfBounds = errors.New("out of bounds")
+
+type Grid[T comparable] struct {
+	width, height int
+	cells         []T
+}
+
+// New returns a grid with all cells set to the zero value.
+func New[T comparable](width, height int) Grid[T] {
+	return Grid[T]{width, height, make([]T, width*height)}
+}
+
+// Filled returns a grid with all cells set to v.
+func Filled[T comparable](width, height int, v T) Grid[T] {
+	return Grid[T]{width, height, slices.Repeat([]T{v}, width*height)}
+}
+
+// FromRows copies rows into a grid, all rows need to be of the same length.
+func FromRows[T comparable](rows [][]T) (Grid[T], error) {
+	if len(rows) == 0 {
+		return Grid[T]{}, nil
+	}
+	g := Grid[T]{len(rows[0]), len(rows), make([]T, 0, len(rows[0])*len(rows))}
+	for y, row := range rows {
+		if len(row) != g.width {
+			return Grid[T]{}, fmt.Errorf("row %d has width %d, expected %d", y, len(row), g.width)
+		}
+		g.cells = append(g.cells, row...)
+	}
+	return g, nil
+}
+
+func (g Grid[T]) Width() int  { return g.width }
+func (g Grid[T]) Height() int { return g.height }
+
+func (g Grid[T]) InBounds(p point.Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < g.width && p.Y < g.height
+}
+
+func (g Grid[T]) At(p point.Point) (T, error) {
+	if !g.InBounds(p) {
+		return *new(T), ErrOutOfBounds
+	}
+	return g.cells[p.Y*g.width+p.X], nil
+}
+
+// Set overwrites the cell at p. The cells are shared between copies of the
+// grid, use Clone to get an independent one.
+func (g Grid[T]) Set(p point.Point, v T) error {
+	if !g.InBounds(p) {
+		return ErrOutOfBounds
+	}
+	g.cells[p.Y*g.width+p.X] = v
+	return nil
+}
+
+// Fill sets every cell to v.
+func (g Grid[T]) Fill(v T) {
+	for i := range g.cells {
+		g.cells[i] = v
+	}
+}
+
+// Row returns the cells of row y. The slice shares memory with the grid. A
+// row outside the grid is nil.
+func (g Grid[T]) Row(y int) []T {
+	if y < 0 || y >= g.height {
+		return nil
+	}
+	return g.cells[y*g.width : (y+1)*g.width : (y+1)*g.width]
+}
+
+// Col yields the cells of column x from top to bottom together with their y.
+// A column outside the grid yields nothing.
+func (g Grid[T]) Col(x int) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if x < 0 || x >= g.width {
+			return
+		}
+		for y := range g.height {
+			if !yield(y, g.cells[y*g.width+x]) {
+				return
+			}
+		}
+	}
+}
+
+// All yields every cell row by row.
+func (g Grid[T]) All() iter.Seq2[point.Point, T] {
+	return func(yield func(point.Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(point.Point{X: i % g.width, Y: i / g.width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Points yields the position of every cell row by row.
+func (g Grid[T]) Points() iter.Seq[point.Point] {
+	return func(yield func(point.Point) bool) {
+		for y := range g.height {
+			for x := range g.width {
+				if !yield(point.Point{X: x, Y: y}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g Grid[T]) FindFirst(v T) (point.Point, error) {
+	i := slices.Index(g.cells, v)
+	if i < 0 {
+		return point.Point{}, fmt.Errorf("%v not found", v)
+	}
+	return point.Point{X: i % g.width, Y: i / g.width}, nil
+}
+
+func (g Grid[T]) FindAll(v T) []point.Point {
+	var ret []point.Point
+	for p, c := range g.All() {
+		if c == v {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+func (g Grid[T]) Clone() Grid[T] {
+	return Grid[T]{g.width, g.height, slices.Clone(g.cells)}
+}
+
+// Padded returns a copy of the grid surrounded by a border of v.
+func (g Grid[T]) Padded(v T) Grid[T] {
+	ret := Filled(g.width+2, g.height+2, v)
+	for y := range g.height {
+		copy(ret.Row(y + 1)[1:], g.Row(y))
+	}
+	return ret
+}
+
+func (g Grid[T]) Print() {
+	for y := range g.height {
+		fmt.Println(g.Row(y))
+	}
+}
@@ -0,0 +1,83 @@
+package grid
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func TestGrid(t *testing.T) {
+	g, err := FromRows([][]int{{1, 2, 3}, {4, 5, 6}})
+	utils.MustNil(err)
+	utils.MustEq(g.Width(), 3)
+	utils.MustEq(g.Height(), 2)
+
+	v, err := g.At(point.Point{X: 2, Y: 1})
+	utils.MustNil(err)
+	utils.MustEq(v, 6)
+	_, err = g.At(point.Point{X: 3, Y: 0})
+	utils.MustEq(err, ErrOutOfBounds)
+	_, err = g.At(point.Point{X: 0, Y: -1})
+	utils.MustEq(err, ErrOutOfBounds)
+
+	clone := g.Clone()
+	utils.MustNil(g.Set(point.Point{X: 1, Y: 0}, 9))
+	utils.MustEq(g.Set(point.Point{X: 1, Y: 2}, 9), ErrOutOfBounds)
+	utils.MustSliceEq(g.Row(0), []int{1, 9, 3})
+	utils.MustSliceEq(clone.Row(0), []int{1, 2, 3})
+	utils.MustEq(len(g.Row(0)), 3)
+
+	var col []int
+	for y, v := range g.Col(1) {
+		utils.MustEq(len(col), y)
+		col = append(col, v)
+	}
+	utils.MustSliceEq(col, []int{9, 5})
+	for _, x := range []int{-1, 3, 4} {
+		for range g.Col(x) {
+			panic("out of bounds column yielded a cell")
+		}
+	}
+	for _, y := range []int{-1, 2, 3} {
+		utils.MustTrue(g.Row(y) == nil)
+	}
+
+	_, err = FromRows([][]int{{1, 2}, {3}})
+	utils.MustNotNil(err)
+}
+
+func TestIterators(t *testing.T) {
+	g := Filled(2, 2, '.')
+	utils.MustNil(g.Set(point.Point{X: 0, Y: 1}, '#'))
+	utils.MustNil(g.Set(point.Point{X: 1, Y: 1}, '#'))
+
+	var points []point.Point
+	for p, v := range g.All() {
+		if v == '#' {
+			points = append(points, p)
+		}
+	}
+	utils.MustSliceEq(points, []point.Point{{X: 0, Y: 1}, {X: 1, Y: 1}})
+	utils.MustSliceEq(g.FindAll('#'), points)
+
+	first, err := g.FindFirst('#')
+	utils.MustNil(err)
+	utils.MustEq(first, point.Point{X: 0, Y: 1})
+	_, err = g.FindFirst('x')
+	utils.MustNotNil(err)
+
+	n := 0
+	for range g.Points() {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	utils.MustEq(n, 3)
+
+	padded := g.Padded('~')
+	utils.MustEq(padded.Width(), 4)
+	utils.MustSliceEq(padded.Row(0), []rune("~~~~"))
+	utils.MustSliceEq(padded.Row(2), []rune("~##~"))
+}
@@ -0,0 +1,109 @@
+// Package counter counts how often values occur, a multiset. Simulations of
+// many identical items can step each distinct value once instead of every
+// item.
+package counter
+
+import (
+	"iter"
+	"slices"
+)
+
+type Entry[T any] struct {
+	Value T
+	Count int
+}
+
+// Counter iterates its values in the order they were first added.
+type Counter[T comparable] struct {
+	index   map[T]int
+	entries []Entry[T]
+	total   int
+	len     int
+}
+
+func New[T comparable]() *Counter[T] {
+	return &Counter[T]{index: map[T]int{}}
+}
+
+// FromSlice counts every item once.
+func FromSlice[T comparable](items []T) *Counter[T] {
+	c := New[T]()
+	for _, item := range items {
+		c.Add(item, 1)
+	}
+	return c
+}
+
+// Add adds n to the count of v, n may be negative.
+func (c *Counter[T]) Add(v T, n int) {
+	i, ok := c.index[v]
+	if !ok {
+		i = len(c.entries)
+		c.index[v] = i
+		c.entries = append(c.entries, Entry[T]{Value: v})
+	}
+	e := &c.entries[i]
+	if e.Count == 0 && n != 0 {
+		c.len++
+	}
+	e.Count += n
+	if e.Count == 0 && n != 0 {
+		c.len--
+	}
+	c.total += n
+}
+
+// Get returns the count of v, 0 if it was never added.
+func (c *Counter[T]) Get(v T) int {
+	if i, ok := c.index[v]; ok {
+		return c.entries[i].Count
+	}
+	return 0
+}
+
+// Total is the sum of all counts.
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Len is the number of distinct values with a count other than 0.
+func (c *Counter[T]) Len() int {
+	return c.len
+}
+
+// All yields every value with a count other than 0 and its count.
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for _, e := range c.entries {
+			if e.Count != 0 && !yield(e.Value, e.Count) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns the k values with the highest counts, ties in the order
+// the values were added. k <= 0 returns all of them.
+func (c *Counter[T]) MostCommon(k int) []Entry[T] {
+	entries := make([]Entry[T], 0, c.len)
+	for v, n := range c.All() {
+		entries = append(entries, Entry[T]{v, n})
+	}
+	slices.SortStableFunc(entries, func(a, b Entry[T]) int { return b.Count - a.Count })
+	if k > 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+	return entries
+}
+
+// Transform returns a new counter where every value is replaced by the
+// values f maps it to, each of them getting the count of the original.
+func (c *Counter[T]) Transform(f func(T) []T) *Counter[T] {
+	ret := New[T]()
+	for v, n := range c.All() {
+		for _, nv := range f(v) {
+			ret.Add(nv, n)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,55 @@
+package counter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestCounter(t *testing.T) {
+	c := FromSlice(strings.Split("b a c a b a", " "))
+	utils.MustEq(c.Get("a"), 3)
+	utils.MustEq(c.Get("x"), 0)
+	utils.MustEq(c.Total(), 6)
+	utils.MustEq(c.Len(), 3)
+
+	var order []string
+	for v := range c.All() {
+		order = append(order, v)
+	}
+	utils.MustSliceEq(order, []string{"b", "a", "c"})
+
+	utils.MustSliceEq(c.MostCommon(2), []Entry[string]{{"a", 3}, {"b", 2}})
+	utils.MustEq(len(c.MostCommon(0)), 3)
+
+	c.Add("c", -1)
+	utils.MustEq(c.Len(), 2)
+	utils.MustEq(c.Total(), 5)
+	c.Add("d", 4)
+	c.Add("c", 2)
+	order = nil
+	for v, n := range c.All() {
+		order = append(order, v)
+		utils.MustEq(n, c.Get(v))
+	}
+	utils.MustSliceEq(order, []string{"b", "a", "c", "d"})
+	utils.MustSliceEq(c.MostCommon(1), []Entry[string]{{"d", 4}})
+}
+
+func TestTransform(t *testing.T) {
+	c := FromSlice([]int{1, 2, 2})
+	// 1 turns into two 3s, both 2s disappear
+	c = c.Transform(func(v int) []int {
+		switch v {
+		case 1:
+			return []int{3, 3}
+		case 2:
+			return nil
+		}
+		return []int{v}
+	})
+	utils.MustEq(c.Total(), 2)
+	utils.MustEq(c.Get(3), 2)
+	utils.MustEq(c.Len(), 1)
+}
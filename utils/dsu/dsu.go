@@ -0,0 +1,140 @@
+// Package dsu is a disjoint set union, also known as union-find. It tracks
+// which elements are connected while connections are only ever added.
+package dsu
+
+type DSU[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	size   []int
+	count  int
+}
+
+func New[T comparable]() *DSU[T] {
+	return &DSU[T]{index: map[T]int{}}
+}
+
+// Add puts x into a component of its own, unless it is already known.
+func (d *DSU[T]) Add(x T) {
+	d.id(x)
+}
+
+func (d *DSU[T]) id(x T) int {
+	if i, ok := d.index[x]; ok {
+		return i
+	}
+	i := len(d.items)
+	d.index[x] = i
+	d.items = append(d.items, x)
+	d.parent = append(d.parent, i)
+	d.size = append(d.size, 1)
+	d.count++
+	return i
+}
+
+func (d *DSU[T]) root(i int) int {
+	for d.parent[i] != i {
+		// path halving, every other node on the way points to its grandparent
+		d.parent[i] = d.parent[d.parent[i]]
+		i = d.parent[i]
+	}
+	return i
+}
+
+// lookup returns the root of x without adding it, ok is false if x is
+// unknown.
+func (d *DSU[T]) lookup(x T) (root int, ok bool) {
+	i, ok := d.index[x]
+	if !ok {
+		return 0, false
+	}
+	return d.root(i), true
+}
+
+// Find returns the representative of the component of x. An unknown element
+// is its own representative.
+func (d *DSU[T]) Find(x T) T {
+	r, ok := d.lookup(x)
+	if !ok {
+		return x
+	}
+	return d.items[r]
+}
+
+// Union merges the components of a and b and reports whether they were
+// separate before. Unknown elements are added first.
+func (d *DSU[T]) Union(a, b T) bool {
+	ra, rb := d.root(d.id(a)), d.root(d.id(b))
+	if ra == rb {
+		return false
+	}
+	if d.size[ra] < d.size[rb] {
+		ra, rb = rb, ra
+	}
+	d.parent[rb] = ra
+	d.size[ra] += d.size[rb]
+	d.count--
+	return true
+}
+
+// Connected reports whether a and b are in the same component, unknown
+// elements are connected to nothing.
+func (d *DSU[T]) Connected(a, b T) bool {
+	ra, okA := d.lookup(a)
+	rb, okB := d.lookup(b)
+	return okA && okB && ra == rb
+}
+
+// Size returns the number of elements in the component of x, 0 if x is
+// unknown.
+func (d *DSU[T]) Size(x T) int {
+	r, ok := d.lookup(x)
+	if !ok {
+		return 0
+	}
+	return d.size[r]
+}
+
+// Count returns the number of components.
+func (d *DSU[T]) Count() int {
+	return d.count
+}
+
+// Len returns the number of elements.
+func (d *DSU[T]) Len() int {
+	return len(d.items)
+}
+
+// Members returns the elements in the component of x in the order they were
+// added, nil if x is unknown.
+func (d *DSU[T]) Members(x T) []T {
+	r, ok := d.lookup(x)
+	if !ok {
+		return nil
+	}
+	members := make([]T, 0, d.size[r])
+	for i, item := range d.items {
+		if d.root(i) == r {
+			members = append(members, item)
+		}
+	}
+	return members
+}
+
+// Components returns all components, ordered by their first added element.
+// Elements within a component are in the order they were added.
+func (d *DSU[T]) Components() [][]T {
+	byRoot := map[int]int{}
+	var components [][]T
+	for i, item := range d.items {
+		r := d.root(i)
+		c, ok := byRoot[r]
+		if !ok {
+			c = len(components)
+			byRoot[r] = c
+			components = append(components, make([]T, 0, d.size[r]))
+		}
+		components[c] = append(components[c], item)
+	}
+	return components
+}
@@ -0,0 +1,64 @@
+package dsu
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func TestDSU(t *testing.T) {
+	d := New[string]()
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		d.Add(s)
+	}
+	utils.MustEq(d.Count(), 5)
+
+	utils.MustTrue(d.Union("a", "b"))
+	utils.MustTrue(d.Union("d", "c"))
+	utils.MustTrue(d.Union("b", "c"))
+	utils.MustFalse(d.Union("a", "d"))
+	utils.MustEq(d.Count(), 2)
+	utils.MustEq(d.Size("c"), 4)
+	utils.MustEq(d.Size("e"), 1)
+	utils.MustTrue(d.Connected("a", "d"))
+	utils.MustFalse(d.Connected("a", "e"))
+	utils.MustEq(d.Find("a"), d.Find("d"))
+
+	utils.MustSliceEq(d.Members("d"), []string{"a", "b", "c", "d"})
+	components := d.Components()
+	utils.MustEq(len(components), 2)
+	utils.MustSliceEq(components[1], []string{"e"})
+
+	// queries about unknown elements don't add them
+	utils.MustFalse(d.Connected("x", "a"))
+	utils.MustFalse(d.Connected("x", "x"))
+	utils.MustEq(d.Find("x"), "x")
+	utils.MustEq(d.Size("x"), 0)
+	utils.MustTrue(d.Members("x") == nil)
+	utils.MustEq(d.Count(), 2)
+	utils.MustEq(d.Len(), 5)
+
+	// Union adds them
+	utils.MustTrue(d.Union("x", "e"))
+	utils.MustEq(d.Count(), 2)
+	utils.MustEq(d.Len(), 6)
+}
+
+func TestRegions(t *testing.T) {
+	g, err := parse.Grid(parse.Lines("AAB\nCAB\nCCA"), parse.Byte)
+	utils.MustNil(err)
+
+	d := Regions(g)
+	utils.MustEq(d.Count(), 4)
+	utils.MustEq(d.Size(point.Point{X: 0, Y: 0}), 3)
+	// diagonal cells don't touch
+	utils.MustFalse(d.Connected(point.Point{X: 1, Y: 1}, point.Point{X: 2, Y: 2}))
+
+	labels, n := Label(g)
+	utils.MustEq(n, 4)
+	utils.MustSliceEq(labels.Row(0), []int{0, 0, 1})
+	utils.MustSliceEq(labels.Row(1), []int{2, 0, 1})
+	utils.MustSliceEq(labels.Row(2), []int{2, 2, 3})
+}
@@ -0,0 +1,39 @@
+package dsu
+
+import (
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+// Regions connects all cells of g with their horizontal and vertical
+// neighbors of the same value.
+func Regions[T comparable](g grid.Grid[T]) *DSU[point.Point] {
+	d := New[point.Point]()
+	for p, v := range g.All() {
+		d.Add(p)
+		for _, nb := range []point.Point{p.Move(point.Left), p.Move(point.Up)} {
+			if w, err := g.At(nb); err == nil && w == v {
+				d.Union(p, nb)
+			}
+		}
+	}
+	return d
+}
+
+// Label numbers the regions of equal value in g from 0 in the order their
+// first cell appears row by row, and returns the labels and region count.
+func Label[T comparable](g grid.Grid[T]) (grid.Grid[int], int) {
+	d := Regions(g)
+	labels := grid.New[int](g.Width(), g.Height())
+	byRoot := map[point.Point]int{}
+	for p := range g.Points() {
+		root := d.Find(p)
+		label, ok := byRoot[root]
+		if !ok {
+			label = len(byRoot)
+			byRoot[root] = label
+		}
+		labels.Set(p, label)
+	}
+	return labels, len(byRoot)
+}
@@ -0,0 +1,113 @@
+// Package memo caches the results of pure, possibly recursive functions.
+package memo
+
+import "sync"
+
+// Func is a memoized function from K to V. The wrapped function gets itself
+// passed in as recurse, calls through it hit the cache as well.
+type Func[K comparable, V any] struct {
+	f     func(recurse func(K) V, k K) V
+	cache map[K]V
+	limit int
+	// order holds the cached keys oldest first while there is a limit
+	order []K
+	stats Stats
+
+	// mu is only used by the variant returned from NewSync
+	mu   sync.Mutex
+	sync bool
+}
+
+type Stats struct {
+	Hits, Misses int
+	// Size is the number of cached results.
+	Size int
+}
+
+type options struct {
+	limit int
+}
+
+type Option func(*options)
+
+// WithLimit keeps at most n results, the oldest are dropped first.
+func WithLimit(n int) Option {
+	return func(o *options) { o.limit = n }
+}
+
+func New[K comparable, V any](f func(recurse func(K) V, k K) V, opts ...Option) *Func[K, V] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Func[K, V]{f: f, cache: map[K]V{}, limit: o.limit}
+}
+
+// NewSync is New for functions called from several goroutines. The lock is
+// not held while f runs, so two goroutines may compute the same key at once.
+func NewSync[K comparable, V any](f func(recurse func(K) V, k K) V, opts ...Option) *Func[K, V] {
+	m := New(f, opts...)
+	m.sync = true
+	return m
+}
+
+func (m *Func[K, V]) lock() {
+	if m.sync {
+		m.mu.Lock()
+	}
+}
+
+func (m *Func[K, V]) unlock() {
+	if m.sync {
+		m.mu.Unlock()
+	}
+}
+
+// Call returns the cached result for k, computing it first on a miss.
+func (m *Func[K, V]) Call(k K) V {
+	m.lock()
+	v, hit := m.cache[k]
+	if hit {
+		m.stats.Hits++
+	} else {
+		m.stats.Misses++
+	}
+	m.unlock()
+	if hit {
+		return v
+	}
+
+	v = m.f(m.Call, k)
+
+	m.lock()
+	defer m.unlock()
+	if _, exists := m.cache[k]; exists {
+		return v
+	}
+	if m.limit > 0 {
+		if len(m.order) >= m.limit {
+			delete(m.cache, m.order[0])
+			m.order = m.order[1:]
+		}
+		m.order = append(m.order, k)
+	}
+	m.cache[k] = v
+	return v
+}
+
+func (m *Func[K, V]) Stats() Stats {
+	m.lock()
+	defer m.unlock()
+	s := m.stats
+	s.Size = len(m.cache)
+	return s
+}
+
+// Reset drops all cached results and the stats.
+func (m *Func[K, V]) Reset() {
+	m.lock()
+	defer m.unlock()
+	m.cache = map[K]V{}
+	m.order = nil
+	m.stats = Stats{}
+}
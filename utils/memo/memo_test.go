@@ -0,0 +1,62 @@
+package memo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func fib(recurse func(int) int, n int) int {
+	if n < 2 {
+		return n
+	}
+	return recurse(n-1) + recurse(n-2)
+}
+
+func TestFunc(t *testing.T) {
+	calls := 0
+	m := New(func(recurse func(int) int, n int) int {
+		calls++
+		return fib(recurse, n)
+	})
+
+	utils.MustEq(m.Call(90), 2880067194370816120)
+	utils.MustEq(calls, 91)
+	utils.MustEq(m.Stats(), Stats{Hits: 88, Misses: 91, Size: 91})
+
+	utils.MustEq(m.Call(50), 12586269025)
+	utils.MustEq(calls, 91)
+
+	m.Reset()
+	utils.MustEq(m.Stats(), Stats{})
+	m.Call(10)
+	utils.MustEq(calls, 102)
+}
+
+func TestLimit(t *testing.T) {
+	m := New(fib, WithLimit(5))
+	utils.MustEq(m.Call(40), 102334155)
+	utils.MustEq(m.Stats().Size, 5)
+
+	// old results are gone, recent ones are still there
+	misses := m.Stats().Misses
+	m.Call(36)
+	utils.MustEq(m.Stats().Misses, misses)
+	m.Call(0)
+	utils.MustEq(m.Stats().Misses, misses+1)
+}
+
+func TestSync(t *testing.T) {
+	m := NewSync(fib)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			utils.MustEq(m.Call(60+i), New(fib).Call(60+i))
+		}()
+	}
+	wg.Wait()
+	utils.MustEq(m.Stats().Size, 68)
+}
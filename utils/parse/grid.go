@@ -0,0 +1,77 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	bytescontainer "github.com/0x28F4/aoc2024/utils/container/bytes"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	strcontainer "github.com/0x28F4/aoc2024/utils/container/string"
+)
+
+var errEmptyGrid = errors.New("empty grid")
+
+// checkRect makes sure all lines are as long as the first one.
+func checkRect(lines []Line) error {
+	if len(lines) == 0 {
+		return &Error{Err: errEmptyGrid}
+	}
+	width := utf8.RuneCountInString(lines[0].Text)
+	for _, l := range lines[1:] {
+		if w := utf8.RuneCountInString(l.Text); w != width {
+			return l.Errorf("expected a row of width %d, got %d", width, w)
+		}
+	}
+	return nil
+}
+
+// Grid converts every character of lines into a cell of a grid. All lines
+// have to be of the same length.
+func Grid[T comparable](lines []Line, cell func(r rune) (T, error)) (grid.Grid[T], error) {
+	if err := checkRect(lines); err != nil {
+		return grid.Grid[T]{}, err
+	}
+
+	g := grid.New[T](utf8.RuneCountInString(lines[0].Text), len(lines))
+	for y, l := range lines {
+		row, x := g.Row(y), 0
+		for i, r := range l.Text {
+			v, err := cell(r)
+			if err != nil {
+				return grid.Grid[T]{}, &Error{Line: l.No, Col: l.Col + i, Err: err}
+			}
+			row[x] = v
+			x++
+		}
+	}
+	return g, nil
+}
+
+// Byte is a cell function for grids of plain ASCII characters.
+func Byte(r rune) (byte, error) {
+	if r >= utf8.RuneSelf {
+		return 0, fmt.Errorf("unexpected non ASCII character %q", r)
+	}
+	return byte(r), nil
+}
+
+// Bytes puts lines of ASCII characters into a byte container.
+func Bytes(lines []Line) (bytescontainer.Container, error) {
+	g, err := Grid(lines, Byte)
+	return bytescontainer.Container{Grid: g}, err
+}
+
+// StringGrid puts lines into a string container after checking that they
+// form a rectangle.
+func StringGrid(lines []Line) (strcontainer.Container, error) {
+	if err := checkRect(lines); err != nil {
+		return strcontainer.Container{}, err
+	}
+
+	texts := make([]string, len(lines))
+	for i, l := range lines {
+		texts[i] = l.Text
+	}
+	return strcontainer.New(texts), nil
+}
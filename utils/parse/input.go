@@ -0,0 +1,107 @@
+package parse
+
+import (
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Read reads all of r and splits it into lines.
+func Read(r io.Reader) ([]Line, error) {
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Lines(string(bytes)), nil
+}
+
+// ReadSections reads all of r and splits it into blank line separated sections.
+func ReadSections(r io.Reader) ([][]Line, error) {
+	lines, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return Sections(lines), nil
+}
+
+// Sections splits lines at blank lines. Runs of blank lines don't produce
+// empty sections.
+func Sections(lines []Line) [][]Line {
+	var sections [][]Line
+	var cur []Line
+	for _, l := range lines {
+		if strings.TrimSpace(l.Text) == "" {
+			if len(cur) > 0 {
+				sections = append(sections, cur)
+			}
+			cur = nil
+			continue
+		}
+		cur = append(cur, l)
+	}
+	if len(cur) > 0 {
+		sections = append(sections, cur)
+	}
+	return sections
+}
+
+// Join concatenates the text of lines, like a multi line instruction list.
+func Join(lines []Line, sep string) string {
+	texts := make([]string, len(lines))
+	for i, l := range lines {
+		texts[i] = l.Text
+	}
+	return strings.Join(texts, sep)
+}
+
+var intRe = regexp.MustCompile(`-?\d+`)
+
+// ExtractInts returns every number in l, ignoring all other characters, so
+// "p=0,4 v=3,-3" yields 0, 4, 3 and -3.
+func (l Line) ExtractInts() ([]int, error) {
+	var ints []int
+	for _, loc := range intRe.FindAllStringIndex(l.Text, -1) {
+		i, err := l.sub(loc[0], l.Text[loc[0]:loc[1]]).Int()
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
+
+// Digits parses every character of l as a single digit number.
+func (l Line) Digits() ([]int, error) {
+	digits := make([]int, 0, len(l.Text))
+	for i, r := range l.Text {
+		if r < '0' || r > '9' {
+			return nil, l.sub(i, string(r)).Errorf("expected a digit, got %q", r)
+		}
+		digits = append(digits, int(r-'0'))
+	}
+	return digits, nil
+}
+
+// IntList parses a list of numbers separated by sep, like "75,47,61".
+// Whitespace around the numbers is ignored.
+func (l Line) IntList(sep string) ([]int, error) {
+	parts, err := l.Split(sep, -1)
+	if err != nil {
+		return nil, err
+	}
+	for i := range parts {
+		parts[i] = parts[i].Trim()
+	}
+	return IntsOf(parts)
+}
+
+// List splits l at sep and trims whitespace around each item, like the
+// towel patterns "r, wr, b".
+func (l Line) List(sep string) []string {
+	parts, _ := l.Split(sep, -1)
+	items := make([]string, len(parts))
+	for i, p := range parts {
+		items[i] = p.Trim().Text
+	}
+	return items
+}
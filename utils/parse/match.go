@@ -0,0 +1,95 @@
+package parse
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Match matches re against l and decodes the submatches into the struct v
+// points to. Named groups are stored in the field of the same name, ignoring
+// case, unnamed groups fill the exported fields in declaration order:
+//
+//	var buttonRe = regexp.MustCompile(`^Button .: X\+(\d+), Y\+(\d+)$`)
+//	var p point.Point
+//	err := line.Match(buttonRe, &p)
+//
+// Fields may be strings or integers.
+func (l Line) Match(re *regexp.Regexp, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("parse: Match needs a pointer to a struct, got %T", v)
+	}
+	st := rv.Elem()
+
+	loc := re.FindStringSubmatchIndex(l.Text)
+	if loc == nil {
+		return l.Errorf("expected %q, got %q", re, l.Text)
+	}
+
+	var exported []int
+	for i := range st.NumField() {
+		if st.Type().Field(i).IsExported() {
+			exported = append(exported, i)
+		}
+	}
+
+	next := 0
+	for group, name := range re.SubexpNames() {
+		if group == 0 {
+			continue
+		}
+
+		var field reflect.Value
+		if name != "" {
+			sf, ok := st.Type().FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, name) })
+			if !ok {
+				return fmt.Errorf("parse: %s has no field for group %q", st.Type(), name)
+			}
+			if !sf.IsExported() {
+				return fmt.Errorf("parse: field %s of %s for group %q is unexported", sf.Name, st.Type(), name)
+			}
+			field = st.FieldByIndex(sf.Index)
+		} else {
+			if next >= len(exported) {
+				return fmt.Errorf("parse: %s has fewer fields than %q has groups", st.Type(), re)
+			}
+			field = st.Field(exported[next])
+			next++
+		}
+
+		start, end := loc[2*group], loc[2*group+1]
+		if start < 0 {
+			// optional group which did not participate in the match
+			continue
+		}
+		if err := setField(field, l.sub(start, l.Text[start:end])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, l Line) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(l.Text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(l.Text, 10, field.Type().Bits())
+		if err != nil {
+			return l.Errorf("invalid number %q", l.Text)
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(l.Text, 10, field.Type().Bits())
+		if err != nil {
+			return l.Errorf("invalid number %q", l.Text)
+		}
+		field.SetUint(u)
+	default:
+		return fmt.Errorf("parse: unsupported field type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Package parse is the error returning counterpart of the utils.Must*
+// helpers. Errors carry the line and column of the offending input so that
+// the runner can report them as "input:42:7: invalid number".
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error is a parse error at a position of the input. Line and Col are
+// 1-based, zero means unknown. File is set by whoever knows where the input
+// came from.
+type Error struct {
+	File string
+	Line int
+	Col  int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	var pos string
+	switch {
+	case e.Line == 0:
+	case e.Col == 0:
+		pos = fmt.Sprintf("%d: ", e.Line)
+	default:
+		pos = fmt.Sprintf("%d:%d: ", e.Line, e.Col)
+	}
+	if e.File != "" {
+		if pos == "" {
+			pos = " "
+		}
+		pos = e.File + ":" + pos
+	}
+	return pos + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Int is strconv.Atoi with a readable error message.
+func Int(s string) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return i, nil
+}
+
+// Line is a line of input, or a part of it, that remembers where it came from.
+type Line struct {
+	Text string
+	// No is the 1-based line number.
+	No int
+	// Col is the 1-based column of Text within the line.
+	Col int
+}
+
+// Lines splits s into lines. Carriage returns and a trailing newline are
+// dropped, so input edited on windows or ending in a newline parses fine.
+func Lines(s string) []Line {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	var lines []Line
+	for i, text := range strings.Split(s, "\n") {
+		lines = append(lines, Line{Text: text, No: i + 1, Col: 1})
+	}
+	return lines
+}
+
+// Errorf returns an error located at the start of l.
+func (l Line) Errorf(format string, args ...any) error {
+	return &Error{Line: l.No, Col: l.Col, Err: fmt.Errorf(format, args...)}
+}
+
+func (l Line) sub(start int, text string) Line {
+	return Line{Text: text, No: l.No, Col: l.Col + start}
+}
+
+// Fields splits l around runs of whitespace like strings.Fields. If n is not
+// negative, exactly n fields are expected.
+func (l Line) Fields(n int) ([]Line, error) {
+	var fields []Line
+	start := -1
+	for i, r := range l.Text + " " {
+		isSpace := r == ' ' || r == '\t'
+		if !isSpace && start < 0 {
+			start = i
+		}
+		if isSpace && start >= 0 {
+			fields = append(fields, l.sub(start, l.Text[start:i]))
+			start = -1
+		}
+	}
+
+	if n >= 0 && len(fields) != n {
+		return nil, l.Errorf("expected %d fields, got %d", n, len(fields))
+	}
+	return fields, nil
+}
+
+// Split slices l into the parts separated by sep. If n is not negative,
+// exactly n parts are expected.
+func (l Line) Split(sep string, n int) ([]Line, error) {
+	var parts []Line
+	start := 0
+	for _, text := range strings.Split(l.Text, sep) {
+		parts = append(parts, l.sub(start, text))
+		start += len(text) + len(sep)
+	}
+
+	if n >= 0 && len(parts) != n {
+		return nil, l.Errorf("expected %d parts separated by %q, got %d", n, sep, len(parts))
+	}
+	return parts, nil
+}
+
+// Trim removes leading and trailing whitespace and keeps the column in sync.
+func (l Line) Trim() Line {
+	trimmed := strings.TrimLeft(l.Text, " \t")
+	return l.sub(len(l.Text)-len(trimmed), strings.TrimRight(trimmed, " \t"))
+}
+
+func (l Line) Int() (int, error) {
+	i, err := Int(strings.TrimSpace(l.Text))
+	if err != nil {
+		return 0, &Error{Line: l.No, Col: l.Col, Err: err}
+	}
+	return i, nil
+}
+
+// Ints parses every whitespace separated field of l as a number.
+func (l Line) Ints() ([]int, error) {
+	fields, err := l.Fields(-1)
+	if err != nil {
+		return nil, err
+	}
+	return IntsOf(fields)
+}
+
+// IntsOf parses each of lines as a number.
+func IntsOf(lines []Line) ([]int, error) {
+	ints := make([]int, 0, len(lines))
+	for _, l := range lines {
+		i, err := l.Int()
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
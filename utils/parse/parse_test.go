@@ -0,0 +1,145 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestLines(t *testing.T) {
+	lines := Lines("a b\r\nc\n")
+	utils.MustLen(lines, 2)
+	utils.MustEq(lines[0].Text, "a b")
+	utils.MustEq(lines[1].Text, "c")
+	utils.MustEq(lines[1].No, 2)
+
+	utils.MustLen(Lines(""), 0)
+}
+
+func TestFields(t *testing.T) {
+	l := Lines("1\n3   4  x")[1]
+
+	_, err := l.Fields(2)
+	utils.MustEq(err.Error(), "2:1: expected 2 fields, got 3")
+
+	fields, err := l.Fields(3)
+	utils.MustNil(err)
+	utils.MustEq(fields[1].Text, "4")
+	utils.MustEq(fields[1].Col, 5)
+
+	_, err = l.Ints()
+	var pe *Error
+	utils.MustTrue(errors.As(err, &pe))
+	utils.MustEq(pe.Line, 2)
+	utils.MustEq(pe.Col, 8)
+	utils.MustEq(err.Error(), `2:8: invalid number "x"`)
+
+	pe.File = "input"
+	wrapped := fmt.Errorf("calibration: %w", err)
+	utils.MustEq(wrapped.Error(), `calibration: input:2:8: invalid number "x"`)
+	pe.Line = 0
+	utils.MustEq(err.Error(), `input: invalid number "x"`)
+}
+
+func TestSplit(t *testing.T) {
+	l := Lines("190: 10 19")[0]
+
+	parts, err := l.Split(":", 2)
+	utils.MustNil(err)
+	v, err := parts[0].Int()
+	utils.MustNil(err)
+	utils.MustEq(v, 190)
+
+	ints, err := parts[1].Ints()
+	utils.MustNil(err)
+	utils.MustSliceEq(ints, []int{10, 19})
+
+	fields, err := parts[1].Fields(2)
+	utils.MustNil(err)
+	utils.MustEq(fields[1].Col, 9)
+
+	_, err = l.Split(",", 2)
+	utils.MustEq(err.Error(), `1:1: expected 2 parts separated by ",", got 1`)
+	utils.MustEq(parts[1].Trim().Col, 6)
+}
+
+func TestSections(t *testing.T) {
+	sections := Sections(Lines("a\nb\n\n\nc\r\n"))
+	utils.MustLen(sections, 2)
+	utils.MustEq(Join(sections[0], ""), "ab")
+	utils.MustEq(sections[1][0].No, 5)
+}
+
+func TestExtractInts(t *testing.T) {
+	ints, err := Lines("p=0,4 v=3,-3")[0].ExtractInts()
+	utils.MustNil(err)
+	utils.MustSliceEq(ints, []int{0, 4, 3, -3})
+
+	ints, err = Lines("75, 47,61")[0].IntList(",")
+	utils.MustNil(err)
+	utils.MustSliceEq(ints, []int{75, 47, 61})
+
+	utils.MustSliceEq(Lines("r, wr, b")[0].List(","), []string{"r", "wr", "b"})
+
+	ints, err = Lines("2333")[0].Digits()
+	utils.MustNil(err)
+	utils.MustSliceEq(ints, []int{2, 3, 3, 3})
+
+	_, err = Lines("23.3")[0].Digits()
+	utils.MustEq(err.Error(), `1:3: expected a digit, got '.'`)
+}
+
+func TestGrid(t *testing.T) {
+	g, err := Grid(Lines("01\n23"), func(r rune) (int, error) { return Int(string(r)) })
+	utils.MustNil(err)
+	utils.MustSliceEq(g.Row(1), []int{2, 3})
+
+	_, err = Grid(Lines("01\n2x"), func(r rune) (int, error) { return Int(string(r)) })
+	utils.MustEq(err.Error(), `2:2: invalid number "x"`)
+
+	_, err = Grid(Lines("ab\nä."), Byte)
+	utils.MustEq(err.Error(), `2:1: unexpected non ASCII character 'ä'`)
+
+	b, err := Bytes(Lines("#.\n.^"))
+	utils.MustNil(err)
+	utils.MustEq(b.Line(1), ".^")
+
+	_, err = StringGrid(Lines("..\n..."))
+	utils.MustEq(err.Error(), "2:1: expected a row of width 2, got 3")
+}
+
+func TestMatch(t *testing.T) {
+	var button struct {
+		X, Y int
+	}
+	re := regexp.MustCompile(`^Button .: X\+(\d+), Y\+(\d+)$`)
+	utils.MustNil(Lines("Button A: X+94, Y+34")[0].Match(re, &button))
+	utils.MustEq(button.X, 94)
+	utils.MustEq(button.Y, 34)
+
+	err := Lines("Button A: X+94")[0].Match(re, &button)
+	utils.MustNotNil(err)
+
+	var named struct {
+		Name  string
+		Count uint8
+	}
+	re = regexp.MustCompile(`^(?P<name>\w+)=(?P<count>\d+)$`)
+	utils.MustNil(Lines("a=7")[0].Match(re, &named))
+	utils.MustEq(named.Name, "a")
+	utils.MustEq(named.Count, uint8(7))
+
+	err = Lines("a=700")[0].Match(re, &named)
+	utils.MustEq(err.Error(), `1:3: invalid number "700"`)
+
+	var hidden struct {
+		name  string
+		Count int
+	}
+	err = Lines("a=7")[0].Match(re, &hidden)
+	utils.MustNotNil(err)
+	utils.MustEq(hidden.name, "")
+}
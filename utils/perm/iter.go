@@ -0,0 +1,200 @@
+package perm
+
+import "iter"
+
+// The iterators below yield the same slice over and over, overwriting its
+// contents for every item. Clone it to keep an item beyond its iteration.
+
+// Product yields every sequence of n picks, each one out of picks, like the
+// digits of a number in base len(picks).
+func Product[T any](n int, picks []T) iter.Seq[[]T] {
+	sets := make([][]T, n)
+	for i := range sets {
+		sets[i] = picks
+	}
+	return Cartesian(sets...)
+}
+
+// Cartesian yields every sequence taking the i-th item out of sets[i]. The
+// last position changes fastest.
+func Cartesian[T any](sets ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, s := range sets {
+			if len(s) == 0 {
+				return
+			}
+		}
+
+		idx := make([]int, len(sets))
+		buf := make([]T, len(sets))
+		for i, s := range sets {
+			buf[i] = s[0]
+		}
+		for {
+			if !yield(buf) {
+				return
+			}
+			// count up like an odometer
+			i := len(sets) - 1
+			for ; i >= 0; i-- {
+				idx[i]++
+				if idx[i] < len(sets[i]) {
+					buf[i] = sets[i][idx[i]]
+					break
+				}
+				idx[i] = 0
+				buf[i] = sets[i][0]
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Permutations yields every ordering of items, in lexicographic order of
+// their positions in items.
+func Permutations[T any](items []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		idx := make([]int, len(items))
+		for i := range idx {
+			idx[i] = i
+		}
+		buf := make([]T, len(items))
+		for {
+			for i, j := range idx {
+				buf[i] = items[j]
+			}
+			if !yield(buf) {
+				return
+			}
+			if !nextPermutation(idx) {
+				return
+			}
+		}
+	}
+}
+
+// nextPermutation rearranges idx into its lexicographic successor and
+// reports false if idx was the last one.
+func nextPermutation(idx []int) bool {
+	i := len(idx) - 2
+	for i >= 0 && idx[i] >= idx[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(idx) - 1
+	for idx[j] <= idx[i] {
+		j--
+	}
+	idx[i], idx[j] = idx[j], idx[i]
+	for l, r := i+1, len(idx)-1; l < r; l, r = l+1, r-1 {
+		idx[l], idx[r] = idx[r], idx[l]
+	}
+	return true
+}
+
+// Combinations yields every subset of k items, each keeping the order of
+// items.
+func Combinations[T any](items []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(items) {
+			return
+		}
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+		buf := make([]T, k)
+		for {
+			for i, j := range idx {
+				buf[i] = items[j]
+			}
+			if !yield(buf) {
+				return
+			}
+			// move the rightmost index which still has room
+			i := k - 1
+			for i >= 0 && idx[i] == len(items)-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+// Subsets yields every subset of items by increasing size, the empty one
+// first.
+func Subsets[T any](items []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for k := range len(items) + 1 {
+			for s := range Combinations(items, k) {
+				if !yield(s) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pairs yields every unordered pair of distinct positions in items.
+func Pairs[T any](items []T) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		for i := range items {
+			for j := i + 1; j < len(items); j++ {
+				if !yield(items[i], items[j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CountProduct is the number of sequences Product(n, picks) yields for k picks.
+func CountProduct(n, k int) int {
+	count := 1
+	for range n {
+		count *= k
+	}
+	return count
+}
+
+// CountPermutations is the number of orderings of n items.
+func CountPermutations(n int) int {
+	count := 1
+	for i := 2; i <= n; i++ {
+		count *= i
+	}
+	return count
+}
+
+// CountCombinations is the number of subsets of k out of n items.
+func CountCombinations(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	k = min(k, n-k)
+	count := 1
+	for i := 1; i <= k; i++ {
+		count = count * (n - k + i) / i
+	}
+	return count
+}
+
+// CountSubsets is the number of subsets of n items.
+func CountSubsets(n int) int {
+	return 1 << n
+}
+
+// CountPairs is the number of unordered pairs of n items.
+func CountPairs(n int) int {
+	return n * (n - 1) / 2
+}
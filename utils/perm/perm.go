@@ -1,41 +1,20 @@
 package perm
 
-func Equal[T any](n int, picks []T) [][]T {
-	var equal func(cur []T, level int) [][]T
-	equal = func(cur []T, level int) [][]T {
-		if level == n {
-			return [][]T{cur}
-		}
+import "slices"
 
-		var ret [][]T
-		for _, p := range picks {
-			var s []T
-			s = append(s, cur...)
-			s = append(s, p)
-			ret = append(ret, equal(s, level+1)...)
-		}
-		return ret
+// Equal returns every sequence of n picks, see Product for a lazy version.
+func Equal[T any](n int, picks []T) [][]T {
+	ret := make([][]T, 0, CountProduct(n, len(picks)))
+	for s := range Product(n, picks) {
+		ret = append(ret, slices.Clone(s))
 	}
-
-	return equal([]T{}, 0)
+	return ret
 }
 
+// EqualFunc calls predicate with every sequence of n picks. The slice is
+// reused between calls.
 func EqualFunc[T any](n int, picks []T, predicate func([]T)) {
-	var equal func(cur []T, level int) [][]T
-	equal = func(cur []T, level int) [][]T {
-		if level == n {
-			predicate(cur)
-		}
-
-		var ret [][]T
-		for _, p := range picks {
-			var s []T
-			s = append(s, cur...)
-			s = append(s, p)
-			ret = append(ret, equal(s, level+1)...)
-		}
-		return ret
+	for s := range Product(n, picks) {
+		predicate(s)
 	}
-
-	equal([]T{}, 0)
 }
@@ -1,6 +1,9 @@
 package perm
 
 import (
+	"iter"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/0x28F4/aoc2024/utils"
@@ -9,4 +12,90 @@ import (
 func TestEqual(t *testing.T) {
 	perms := Equal(3, []string{"a", "b"})
 	utils.MustLen(perms, 2*2*2)
+	utils.MustSliceEq(perms[0], []string{"a", "a", "a"})
+	utils.MustSliceEq(perms[7], []string{"b", "b", "b"})
+
+	n := 0
+	EqualFunc(2, []int{1, 2, 3}, func([]int) { n++ })
+	utils.MustEq(n, 9)
+}
+
+// collect joins every yielded sequence of single letters into a word.
+func collect(seq iter.Seq[[]string]) []string {
+	var words []string
+	for s := range seq {
+		words = append(words, strings.Join(s, ""))
+	}
+	return words
+}
+
+func TestProduct(t *testing.T) {
+	utils.MustSliceEq(collect(Product(2, []string{"a", "b"})), []string{"aa", "ab", "ba", "bb"})
+	utils.MustSliceEq(collect(Product(0, []string{"a"})), []string{""})
+	utils.MustLen(collect(Product(2, []string{})), 0)
+	utils.MustEq(CountProduct(2, 2), 4)
+	utils.MustEq(CountProduct(11, 3), len(collect(Product(11, []string{"+", "*", "||"}))))
+
+	utils.MustSliceEq(collect(Cartesian([]string{"a", "b"}, []string{"x"}, []string{"1", "2"})), []string{"ax1", "ax2", "bx1", "bx2"})
+}
+
+func TestPermutations(t *testing.T) {
+	utils.MustSliceEq(collect(Permutations([]string{"a", "b", "c"})), []string{"abc", "acb", "bac", "bca", "cab", "cba"})
+	utils.MustEq(CountPermutations(3), 6)
+
+	// repeated items are permuted by position
+	utils.MustLen(collect(Permutations([]string{"a", "a"})), 2)
+	utils.MustEq(CountPermutations(0), 1)
+	utils.MustLen(collect(Permutations([]string{})), 1)
+}
+
+func TestCombinations(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	utils.MustSliceEq(collect(Combinations(items, 2)), []string{"ab", "ac", "ad", "bc", "bd", "cd"})
+	utils.MustSliceEq(collect(Combinations(items, 0)), []string{""})
+	utils.MustLen(collect(Combinations(items, 5)), 0)
+	for k := range 5 {
+		utils.MustEq(CountCombinations(4, k), len(collect(Combinations(items, k))))
+	}
+	utils.MustEq(CountCombinations(60, 30), 118264581564861424)
+
+	utils.MustSliceEq(collect(Subsets(items[:3])), []string{"", "a", "b", "c", "ab", "ac", "bc", "abc"})
+	utils.MustEq(CountSubsets(3), 8)
+}
+
+func TestPairs(t *testing.T) {
+	var pairs []string
+	for a, b := range Pairs([]string{"a", "b", "c"}) {
+		pairs = append(pairs, a+b)
+	}
+	utils.MustSliceEq(pairs, []string{"ab", "ac", "bc"})
+	utils.MustEq(CountPairs(3), 3)
+}
+
+func TestBreak(t *testing.T) {
+	var seen [][]int
+	for s := range Permutations([]int{1, 2, 3, 4}) {
+		seen = append(seen, slices.Clone(s))
+		if len(seen) == 2 {
+			break
+		}
+	}
+	utils.MustLen(seen, 2)
+	utils.MustSliceEq(seen[1], []int{1, 2, 4, 3})
+
+	n := 0
+	for range Subsets([]int{1, 2, 3}) {
+		n++
+		if n == 5 {
+			break
+		}
+	}
+	utils.MustEq(n, 5)
+
+	// the buffer is reused, which is what keeps the iteration cheap
+	allocs := testing.AllocsPerRun(10, func() {
+		for range Product(8, []int{1, 2, 3}) {
+		}
+	})
+	utils.MustSmaller(allocs, 10.0)
 }
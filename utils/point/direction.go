@@ -0,0 +1,119 @@
+package point
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Direction is one of the eight compass directions. Y grows downwards like
+// the rows of a puzzle map, so North is up.
+type Direction int
+
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+const (
+	Up    = North
+	Right = East
+	Down  = South
+	Left  = West
+)
+
+// Cardinals are the four directions along the axes, clockwise from North.
+var Cardinals = []Direction{North, East, South, West}
+
+// Directions are all eight directions, clockwise from North.
+var Directions = []Direction{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+
+var vectors = [...]Point{
+	North:     {X: 0, Y: -1},
+	NorthEast: {X: 1, Y: -1},
+	East:      {X: 1, Y: 0},
+	SouthEast: {X: 1, Y: 1},
+	South:     {X: 0, Y: 1},
+	SouthWest: {X: -1, Y: 1},
+	West:      {X: -1, Y: 0},
+	NorthWest: {X: -1, Y: -1},
+}
+
+var names = [...]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// Vector is the offset of a single step in direction d. It panics if d is
+// not one of the eight directions, ParseDirection only returns valid ones.
+func (d Direction) Vector() Point {
+	if d < 0 || int(d) >= len(vectors) {
+		panic(fmt.Sprintf("point: invalid direction %d", int(d)))
+	}
+	return vectors[d]
+}
+
+// TurnRight turns clockwise by 90 degrees.
+func (d Direction) TurnRight() Direction {
+	return (d + 2) % 8
+}
+
+// TurnLeft turns counterclockwise by 90 degrees.
+func (d Direction) TurnLeft() Direction {
+	return (d + 6) % 8
+}
+
+func (d Direction) Opposite() Direction {
+	return (d + 4) % 8
+}
+
+func (d Direction) String() string {
+	if d < 0 || int(d) >= len(names) {
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+	return names[d]
+}
+
+// ParseDirection reads arrows ^v<>, the letters UDLR or the compass points
+// NESW.
+func ParseDirection(r rune) (Direction, error) {
+	switch r {
+	case '^', 'U', 'N':
+		return North, nil
+	case '>', 'R', 'E':
+		return East, nil
+	case 'v', 'D', 'S':
+		return South, nil
+	case '<', 'L', 'W':
+		return West, nil
+	}
+	return 0, fmt.Errorf("invalid direction %q", r)
+}
+
+// Move returns the point one step from p in direction d.
+func (p Point) Move(d Direction) Point {
+	return p.Add(d.Vector())
+}
+
+// Neighbors4 yields the four points sharing an edge with p, together with
+// the direction they are in.
+func (p Point) Neighbors4() iter.Seq2[Direction, Point] {
+	return p.neighbors(Cardinals)
+}
+
+// Neighbors8 yields the eight points around p, diagonals included.
+func (p Point) Neighbors8() iter.Seq2[Direction, Point] {
+	return p.neighbors(Directions)
+}
+
+func (p Point) neighbors(dirs []Direction) iter.Seq2[Direction, Point] {
+	return func(yield func(Direction, Point) bool) {
+		for _, d := range dirs {
+			if !yield(d, p.Move(d)) {
+				return
+			}
+		}
+	}
+}
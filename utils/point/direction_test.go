@@ -0,0 +1,62 @@
+package point
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestDirection(t *testing.T) {
+	utils.MustEq(Up.TurnRight(), Right)
+	utils.MustEq(Up.TurnLeft(), Left)
+	utils.MustEq(Left.TurnLeft(), Down)
+	utils.MustEq(NorthEast.TurnRight(), SouthEast)
+	utils.MustEq(NorthWest.Opposite(), SouthEast)
+	utils.MustEq(Down.Opposite(), Up)
+
+	for _, d := range Directions {
+		utils.MustEq(d.Vector().Add(d.Opposite().Vector()), Point{})
+		utils.MustEq(d.TurnLeft().TurnRight(), d)
+	}
+	utils.MustEq(Point{X: 2, Y: 2}.Move(Up), Point{X: 2, Y: 1})
+	utils.MustEq(SouthWest.String(), "SW")
+
+	for _, d := range []Direction{-1, 8} {
+		func() {
+			defer func() {
+				utils.MustEq(recover(), any(fmt.Sprintf("point: invalid direction %d", int(d))))
+			}()
+			d.Vector()
+		}()
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	for _, s := range []string{"^>v<", "URDL", "NESW"} {
+		for i, r := range s {
+			d, err := ParseDirection(r)
+			utils.MustNil(err)
+			utils.MustEq(d, Cardinals[i])
+		}
+	}
+	_, err := ParseDirection('x')
+	utils.MustNotNil(err)
+}
+
+func TestNeighbors(t *testing.T) {
+	p := Point{X: 5, Y: 5}
+
+	var nbs []Point
+	for d, nb := range p.Neighbors4() {
+		utils.MustEq(nb.Sub(p), d.Vector())
+		nbs = append(nbs, nb)
+	}
+	utils.MustSliceEq(nbs, []Point{{X: 5, Y: 4}, {X: 6, Y: 5}, {X: 5, Y: 6}, {X: 4, Y: 5}})
+
+	n := 0
+	for range p.Neighbors8() {
+		n++
+	}
+	utils.MustEq(n, 8)
+}
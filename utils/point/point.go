@@ -76,16 +76,3 @@ func (p Point) MulScal(s int) Point {
 		p.Y * s,
 	}
 }
-
-type DirFn func(s Point) Point
-
-var (
-	UP        DirFn = func(s Point) Point { return Point{X: s.X, Y: s.Y - 1} }
-	DOWN      DirFn = func(s Point) Point { return Point{X: s.X, Y: s.Y + 1} }
-	LEFT      DirFn = func(s Point) Point { return Point{X: s.X - 1, Y: s.Y} }
-	RIGHT     DirFn = func(s Point) Point { return Point{X: s.X + 1, Y: s.Y} }
-	DOWNRIGHT DirFn = func(p Point) Point { return Point{X: p.X + 1, Y: p.Y + 1} }
-	UPRIGHT   DirFn = func(p Point) Point { return Point{X: p.X + 1, Y: p.Y - 1} }
-	DOWNLEFT  DirFn = func(p Point) Point { return Point{X: p.X - 1, Y: p.Y + 1} }
-	UPLEFT    DirFn = func(p Point) Point { return Point{X: p.X - 1, Y: p.Y - 1} }
-)
@@ -0,0 +1,139 @@
+package pq
+
+import "cmp"
+
+// Indexed is a priority queue of keys which knows where each key is in the
+// heap, so the priority of a queued key can be changed in place.
+type Indexed[K comparable, P cmp.Ordered] struct {
+	entries []entry[K, P]
+	index   map[K]int
+	before  func(a, b P) bool
+}
+
+// NewIndexedMin returns a queue popping the lowest priority first.
+func NewIndexedMin[K comparable, P cmp.Ordered]() *Indexed[K, P] {
+	return &Indexed[K, P]{index: map[K]int{}, before: cmp.Less[P]}
+}
+
+// NewIndexedMax returns a queue popping the highest priority first.
+func NewIndexedMax[K comparable, P cmp.Ordered]() *Indexed[K, P] {
+	return &Indexed[K, P]{index: map[K]int{}, before: greater[P]}
+}
+
+func (q *Indexed[K, P]) Len() int {
+	return len(q.entries)
+}
+
+func (q *Indexed[K, P]) Contains(k K) bool {
+	_, ok := q.index[k]
+	return ok
+}
+
+// Priority returns the priority of a queued key.
+func (q *Indexed[K, P]) Priority(k K) (prio P, ok bool) {
+	i, ok := q.index[k]
+	if !ok {
+		return prio, false
+	}
+	return q.entries[i].prio, true
+}
+
+// Push queues k, or changes its priority if it is queued already.
+func (q *Indexed[K, P]) Push(k K, prio P) {
+	if i, ok := q.index[k]; ok {
+		q.entries[i].prio = prio
+		q.fix(i)
+		return
+	}
+	q.entries = append(q.entries, entry[K, P]{k, prio})
+	q.index[k] = len(q.entries) - 1
+	q.up(len(q.entries) - 1)
+}
+
+// Update changes the priority of a queued key and reports whether it was
+// queued.
+func (q *Indexed[K, P]) Update(k K, prio P) bool {
+	i, ok := q.index[k]
+	if !ok {
+		return false
+	}
+	q.entries[i].prio = prio
+	q.fix(i)
+	return true
+}
+
+// Decrease lowers the priority of k if prio comes before the current one,
+// queueing k if it isn't yet. It reports whether anything changed. This is
+// the decrease-key of Dijkstra on a min queue.
+func (q *Indexed[K, P]) Decrease(k K, prio P) bool {
+	if current, ok := q.Priority(k); ok && !q.before(prio, current) {
+		return false
+	}
+	q.Push(k, prio)
+	return true
+}
+
+// Peek returns the next key without removing it. It panics on an empty
+// queue.
+func (q *Indexed[K, P]) Peek() (K, P) {
+	if len(q.entries) == 0 {
+		panic("called Peek on empty queue")
+	}
+	return q.entries[0].value, q.entries[0].prio
+}
+
+// Pop removes and returns the next key. It panics on an empty queue.
+func (q *Indexed[K, P]) Pop() (K, P) {
+	if len(q.entries) == 0 {
+		panic("called Pop on empty queue")
+	}
+	top := q.entries[0]
+	last := len(q.entries) - 1
+	q.swap(0, last)
+	q.entries[last] = entry[K, P]{}
+	q.entries = q.entries[:last]
+	delete(q.index, top.value)
+	q.down(0)
+	return top.value, top.prio
+}
+
+func (q *Indexed[K, P]) swap(i, j int) {
+	q.entries[i], q.entries[j] = q.entries[j], q.entries[i]
+	q.index[q.entries[i].value] = i
+	q.index[q.entries[j].value] = j
+}
+
+func (q *Indexed[K, P]) fix(i int) {
+	if !q.up(i) {
+		q.down(i)
+	}
+}
+
+// up moves entry i towards the root and reports whether it moved.
+func (q *Indexed[K, P]) up(i int) (moved bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.before(q.entries[i].prio, q.entries[parent].prio) {
+			return moved
+		}
+		q.swap(i, parent)
+		i, moved = parent, true
+	}
+	return moved
+}
+
+func (q *Indexed[K, P]) down(i int) {
+	for {
+		first := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(q.entries) && q.before(q.entries[child].prio, q.entries[first].prio) {
+				first = child
+			}
+		}
+		if first == i {
+			return
+		}
+		q.swap(i, first)
+		i = first
+	}
+}
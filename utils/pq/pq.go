@@ -0,0 +1,88 @@
+// Package pq has priority queues backed by binary heaps. Queue holds any
+// values, Indexed holds each key at most once and can change its priority.
+package pq
+
+import "cmp"
+
+type entry[T any, P cmp.Ordered] struct {
+	value T
+	prio  P
+}
+
+type Queue[T any, P cmp.Ordered] struct {
+	entries []entry[T, P]
+	before  func(a, b P) bool
+}
+
+// NewMin returns a queue popping the lowest priority first.
+func NewMin[T any, P cmp.Ordered]() *Queue[T, P] {
+	return &Queue[T, P]{before: cmp.Less[P]}
+}
+
+// NewMax returns a queue popping the highest priority first.
+func NewMax[T any, P cmp.Ordered]() *Queue[T, P] {
+	return &Queue[T, P]{before: greater[P]}
+}
+
+func greater[P cmp.Ordered](a, b P) bool {
+	return cmp.Less(b, a)
+}
+
+func (q *Queue[T, P]) Len() int {
+	return len(q.entries)
+}
+
+func (q *Queue[T, P]) Push(v T, prio P) {
+	q.entries = append(q.entries, entry[T, P]{v, prio})
+	q.up(len(q.entries) - 1)
+}
+
+// Peek returns the next value without removing it. It panics on an empty
+// queue.
+func (q *Queue[T, P]) Peek() (T, P) {
+	if len(q.entries) == 0 {
+		panic("called Peek on empty queue")
+	}
+	return q.entries[0].value, q.entries[0].prio
+}
+
+// Pop removes and returns the next value. It panics on an empty queue.
+func (q *Queue[T, P]) Pop() (T, P) {
+	if len(q.entries) == 0 {
+		panic("called Pop on empty queue")
+	}
+	top := q.entries[0]
+	last := len(q.entries) - 1
+	q.entries[0] = q.entries[last]
+	q.entries[last] = entry[T, P]{}
+	q.entries = q.entries[:last]
+	q.down(0)
+	return top.value, top.prio
+}
+
+func (q *Queue[T, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.before(q.entries[i].prio, q.entries[parent].prio) {
+			return
+		}
+		q.entries[i], q.entries[parent] = q.entries[parent], q.entries[i]
+		i = parent
+	}
+}
+
+func (q *Queue[T, P]) down(i int) {
+	for {
+		first := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(q.entries) && q.before(q.entries[child].prio, q.entries[first].prio) {
+				first = child
+			}
+		}
+		if first == i {
+			return
+		}
+		q.entries[i], q.entries[first] = q.entries[first], q.entries[i]
+		i = first
+	}
+}
@@ -0,0 +1,109 @@
+package pq
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewMin[string, int]()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("d", 4)
+	q.Push("b", 2)
+	utils.MustEq(q.Len(), 4)
+
+	v, p := q.Peek()
+	utils.MustEq(v, "a")
+	utils.MustEq(p, 1)
+	utils.MustEq(q.Len(), 4)
+
+	var popped []string
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		popped = append(popped, v)
+	}
+	utils.MustSliceEq(popped, []string{"a", "b", "c", "d"})
+
+	max := NewMax[string, float64]()
+	max.Push("low", 0.5)
+	max.Push("high", 2.5)
+	v, _ = max.Pop()
+	utils.MustEq(v, "high")
+}
+
+func TestQueueRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	q := NewMin[int, int]()
+	var want []int
+	for range 1000 {
+		p := rng.Intn(100)
+		q.Push(p, p)
+		want = append(want, p)
+	}
+	slices.Sort(want)
+	for _, w := range want {
+		_, p := q.Pop()
+		utils.MustEq(p, w)
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	q := NewIndexedMin[string, int]()
+	q.Push("a", 5)
+	q.Push("b", 3)
+	q.Push("c", 4)
+	q.Push("a", 6) // already queued, only changes the priority
+	utils.MustEq(q.Len(), 3)
+
+	utils.MustTrue(q.Decrease("a", 1))
+	utils.MustFalse(q.Decrease("a", 2))
+	utils.MustTrue(q.Update("c", 10))
+	utils.MustFalse(q.Update("x", 0))
+	utils.MustTrue(q.Contains("c"))
+	p, ok := q.Priority("c")
+	utils.MustTrue(ok)
+	utils.MustEq(p, 10)
+
+	var popped []string
+	for q.Len() > 0 {
+		k, _ := q.Pop()
+		popped = append(popped, k)
+	}
+	utils.MustSliceEq(popped, []string{"a", "b", "c"})
+	utils.MustFalse(q.Contains("a"))
+
+	max := NewIndexedMax[int, int]()
+	for i := range 10 {
+		max.Push(i, i)
+	}
+	max.Update(3, 100)
+	max.Update(9, -1)
+	k, _ := max.Pop()
+	utils.MustEq(k, 3)
+	k, _ = max.Pop()
+	utils.MustEq(k, 8)
+}
+
+func TestIndexedRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	q := NewIndexedMin[int, int]()
+	prios := map[int]int{}
+	for range 2000 {
+		k, p := rng.Intn(200), rng.Intn(1000)
+		q.Push(k, p)
+		prios[k] = p
+	}
+	last := -1
+	for q.Len() > 0 {
+		k, p := q.Pop()
+		utils.MustEq(p, prios[k])
+		utils.MustGreaterEq(p, last)
+		last = p
+		delete(prios, k)
+	}
+	utils.MustEq(len(prios), 0)
+}
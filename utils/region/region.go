@@ -0,0 +1,116 @@
+// Package region measures shapes made of grid cells, like the garden plots
+// of day 12. Cells are connected horizontally and vertically.
+package region
+
+import (
+	"iter"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/dsu"
+	"github.com/0x28F4/aoc2024/utils/point"
+	"github.com/0x28F4/aoc2024/utils/set"
+)
+
+type Region struct {
+	cells set.Set[point.Point]
+}
+
+func New(cells ...point.Point) Region {
+	r := Region{set.New[point.Point]()}
+	r.cells.Add(cells...)
+	return r
+}
+
+func (r Region) Contains(p point.Point) bool {
+	return r.cells.Contains(p)
+}
+
+// All yields the cells of the region in no particular order.
+func (r Region) All() iter.Seq[point.Point] {
+	return r.cells.All()
+}
+
+// Area is the number of cells.
+func (r Region) Area() int {
+	return r.cells.Len()
+}
+
+// Perimeter is the number of cell edges between the region and the outside,
+// edges around holes included.
+func (r Region) Perimeter() (perimeter int) {
+	for p := range r.cells {
+		for _, nb := range p.Neighbors4() {
+			if !r.Contains(nb) {
+				perimeter++
+			}
+		}
+	}
+	return perimeter
+}
+
+// Corners counts the corners of the outline, both the ones pointing outwards
+// and inwards.
+func (r Region) Corners() (corners int) {
+	for p := range r.cells {
+		for _, d := range point.Cardinals {
+			a, b := r.Contains(p.Move(d)), r.Contains(p.Move(d.TurnRight()))
+			diagonal := r.Contains(p.Move(d).Move(d.TurnRight()))
+			if (!a && !b) || (a && b && !diagonal) {
+				corners++
+			}
+		}
+	}
+	return corners
+}
+
+// Sides is the number of straight sides of the outline, holes included. A
+// closed outline has as many sides as corners.
+func (r Region) Sides() int {
+	return r.Corners()
+}
+
+// Bounds returns the smallest and the largest coordinates of the region.
+func (r Region) Bounds() (lo, hi point.Point) {
+	first := true
+	for p := range r.cells {
+		if first {
+			lo, hi, first = p, p, false
+			continue
+		}
+		lo = point.Point{X: min(lo.X, p.X), Y: min(lo.Y, p.Y)}
+		hi = point.Point{X: max(hi.X, p.X), Y: max(hi.Y, p.Y)}
+	}
+	return lo, hi
+}
+
+// Holes returns the areas enclosed by the region which don't belong to it.
+func (r Region) Holes() []Region {
+	if r.Area() == 0 {
+		return nil
+	}
+
+	// mark the region on a grid with a free border around the bounding box,
+	// everything outside the region connected to the border isn't a hole
+	lo, hi := r.Bounds()
+	origin := lo.Sub(point.Point{X: 1, Y: 1})
+	g := grid.New[bool](hi.X-lo.X+3, hi.Y-lo.Y+3)
+	for p := range r.cells {
+		utils.MustNil(g.Set(p.Sub(origin), true))
+	}
+
+	regions := dsu.Regions(g)
+	outside := regions.Find(point.Point{})
+	var holes []Region
+	for _, cells := range regions.Components() {
+		if inside, _ := g.At(cells[0]); inside || regions.Find(cells[0]) == outside {
+			continue
+		}
+		hole := New()
+		for _, c := range cells {
+			hole.cells.Add(c.Add(origin))
+		}
+		holes = append(holes, hole)
+	}
+	return holes
+}
@@ -0,0 +1,63 @@
+package region
+
+import (
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+// fromMap returns the region of all # in the map.
+func fromMap(m string) Region {
+	g, err := parse.Grid(parse.Lines(m), parse.Byte)
+	utils.MustNil(err)
+	return New(g.FindAll('#')...)
+}
+
+func TestSingleCell(t *testing.T) {
+	r := New(point.Point{X: 3, Y: 4})
+	utils.MustEq(r.Area(), 1)
+	utils.MustEq(r.Perimeter(), 4)
+	utils.MustEq(r.Sides(), 4)
+	lo, hi := r.Bounds()
+	utils.MustEq(lo, point.Point{X: 3, Y: 4})
+	utils.MustEq(hi, lo)
+	utils.MustLen(r.Holes(), 0)
+}
+
+func TestE(t *testing.T) {
+	// the E shaped region from the day 12 example
+	r := fromMap("#####\n#....\n#####\n#....\n#####")
+	utils.MustEq(r.Area(), 17)
+	utils.MustEq(r.Perimeter(), 36)
+	utils.MustEq(r.Sides(), 12)
+	utils.MustLen(r.Holes(), 0)
+	lo, hi := r.Bounds()
+	utils.MustEq(lo, point.Point{X: 0, Y: 0})
+	utils.MustEq(hi, point.Point{X: 4, Y: 4})
+}
+
+func TestHoles(t *testing.T) {
+	r := fromMap("......\n.####.\n.#..#.\n.#.##.\n.####.\n......")
+	utils.MustEq(r.Area(), 13)
+	utils.MustEq(r.Sides(), 4+6)
+
+	holes := r.Holes()
+	utils.MustLen(holes, 1)
+	utils.MustEq(holes[0].Area(), 3)
+	utils.MustTrue(holes[0].Contains(point.Point{X: 2, Y: 3}))
+	utils.MustFalse(holes[0].Contains(point.Point{X: 1, Y: 1}))
+
+	// regions touching at a corner count their corners twice
+	r = fromMap("#.\n.#")
+	utils.MustEq(r.Sides(), 8)
+	utils.MustEq(r.Perimeter(), 8)
+}
+
+func TestEmpty(t *testing.T) {
+	r := New()
+	utils.MustEq(r.Area(), 0)
+	utils.MustEq(r.Sides(), 0)
+	utils.MustLen(r.Holes(), 0)
+}
@@ -0,0 +1,171 @@
+package search
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/0x28F4/aoc2024/utils/pq"
+	"github.com/0x28F4/aoc2024/utils/set"
+)
+
+// AllResult is like Result but keeps every predecessor on a cheapest path,
+// so it knows about all optimal paths instead of a single one.
+type AllResult[S comparable] struct {
+	Start S
+	Dist  map[S]int
+	// Prev holds all states before each one on any of its cheapest paths.
+	Prev map[S][]S
+	// Goals are the states accepted by the goal predicate at the cheapest
+	// goal distance, empty if none was reached.
+	Goals []S
+}
+
+func newAllResult[S comparable](start S) AllResult[S] {
+	return AllResult[S]{
+		Start: start,
+		Dist:  map[S]int{start: 0},
+		Prev:  map[S][]S{},
+	}
+}
+
+// relax records a path to nxt of cost dist via cur and reports whether it
+// is cheaper than every one known before.
+func (r AllResult[S]) relax(cur, nxt S, dist int) (cheaper bool) {
+	known, seen := r.Dist[nxt]
+	switch {
+	case !seen || dist < known:
+		r.Dist[nxt] = dist
+		r.Prev[nxt] = append(r.Prev[nxt][:0], cur)
+		return true
+	case dist == known:
+		r.Prev[nxt] = append(r.Prev[nxt], cur)
+	}
+	return false
+}
+
+// BFSAll is BFS keeping all predecessors. With a goal it stops once every
+// state as close as the nearest goal is done.
+func BFSAll[S comparable](start S, neighbors func(S) iter.Seq[S], goal func(S) bool) AllResult[S] {
+	r := newAllResult(start)
+	queue := []S{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if len(r.Goals) > 0 && r.Dist[cur] > r.Dist[r.Goals[0]] {
+			break
+		}
+		if goal != nil && goal(cur) {
+			r.Goals = append(r.Goals, cur)
+			continue
+		}
+
+		for nxt := range neighbors(cur) {
+			if r.relax(cur, nxt, r.Dist[cur]+1) {
+				queue = append(queue, nxt)
+			}
+		}
+	}
+	return r
+}
+
+// DijkstraAll is Dijkstra keeping all predecessors. With a goal it stops once
+// every state as cheap as the cheapest goal is done.
+func DijkstraAll[S comparable](start S, neighbors func(S) iter.Seq2[S, int], goal func(S) bool) AllResult[S] {
+	r := newAllResult(start)
+	open := pq.NewIndexedMin[S, int]()
+	open.Push(start, 0)
+	for open.Len() > 0 {
+		cur, dist := open.Pop()
+		if len(r.Goals) > 0 && dist > r.Dist[r.Goals[0]] {
+			break
+		}
+		if goal != nil && goal(cur) {
+			r.Goals = append(r.Goals, cur)
+			continue
+		}
+
+		for nxt, cost := range neighbors(cur) {
+			if r.relax(cur, nxt, dist+cost) {
+				open.Push(nxt, dist+cost)
+			}
+		}
+	}
+	return r
+}
+
+// Count returns the number of distinct cheapest paths from Start to s.
+func (r AllResult[S]) Count(s S) int {
+	if _, ok := r.Dist[s]; !ok {
+		return 0
+	}
+
+	counts := map[S]int{r.Start: 1}
+	var count func(S) int
+	count = func(s S) int {
+		if n, ok := counts[s]; ok {
+			return n
+		}
+		n := 0
+		for _, prev := range r.Prev[s] {
+			n += count(prev)
+		}
+		counts[s] = n
+		return n
+	}
+	return count(s)
+}
+
+// Paths lazily yields every cheapest path from Start to s. The yielded slice
+// is reused, clone it to keep it beyond the iteration.
+func (r AllResult[S]) Paths(s S) iter.Seq[[]S] {
+	return func(yield func([]S) bool) {
+		if _, ok := r.Dist[s]; !ok {
+			return
+		}
+
+		// walk backwards from s, path holds the states from s to the current one
+		path := []S{s}
+		out := make([]S, 0, r.Dist[s]+1)
+		var walk func() bool
+		walk = func() bool {
+			cur := path[len(path)-1]
+			if cur == r.Start {
+				out = append(out[:0], path...)
+				slices.Reverse(out)
+				return yield(out)
+			}
+			for _, prev := range r.Prev[cur] {
+				path = append(path, prev)
+				if !walk() {
+					return false
+				}
+				path = path[:len(path)-1]
+			}
+			return true
+		}
+		walk()
+	}
+}
+
+// Tiles returns every state on any cheapest path from Start to one of to.
+func (r AllResult[S]) Tiles(to ...S) set.Set[S] {
+	tiles := set.New[S]()
+	var stack []S
+	for _, s := range to {
+		if _, ok := r.Dist[s]; ok && !tiles.Contains(s) {
+			tiles.Add(s)
+			stack = append(stack, s)
+		}
+	}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, prev := range r.Prev[cur] {
+			if !tiles.Contains(prev) {
+				tiles.Add(prev)
+				stack = append(stack, prev)
+			}
+		}
+	}
+	return tiles
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+func TestDijkstraAll(t *testing.T) {
+	r := DijkstraAll("a", edges, is("d"))
+	utils.MustSliceEq(r.Goals, []string{"d"})
+	utils.MustEq(r.Dist["d"], 3)
+	utils.MustEq(r.Count("d"), 2)
+	utils.MustEq(r.Count("e"), 0)
+
+	var paths [][]string
+	for p := range r.Paths("d") {
+		paths = append(paths, slices.Clone(p))
+	}
+	slices.SortFunc(paths, slices.Compare)
+	utils.MustEq(len(paths), 2)
+	utils.MustSliceEq(paths[0], []string{"a", "b", "c", "d"})
+	utils.MustSliceEq(paths[1], []string{"a", "b", "d"})
+
+	tiles := r.Tiles("d")
+	utils.MustEq(tiles.Len(), 4)
+}
+
+func TestBFSAll(t *testing.T) {
+	g := Grid[byte]{grid.Filled(3, 3, byte('.')), func(b byte) bool { return b == '#' }}
+	start, end := point.Point{X: 0, Y: 0}, point.Point{X: 2, Y: 2}
+
+	r := BFSAll(start, g.Neighbors, func(p point.Point) bool { return p == end })
+	utils.MustSliceEq(r.Goals, []point.Point{end})
+	utils.MustEq(r.Count(end), 6)
+	utils.MustEq(r.Tiles(end).Len(), 9)
+
+	// the iteration stops early and every path is a shortest one
+	n := 0
+	for p := range r.Paths(end) {
+		utils.MustLen(p, 5)
+		utils.MustEq(p[0], start)
+		utils.MustEq(p[4], end)
+		n++
+		if n == 4 {
+			break
+		}
+	}
+	utils.MustEq(n, 4)
+
+	// block the first step to the right
+	utils.MustNil(g.Set(point.Point{X: 1, Y: 0}, '#'))
+	r = BFSAll(start, g.Neighbors, nil)
+	utils.MustEq(r.Count(end), 3)
+	utils.MustEq(r.Tiles(point.Point{X: 1, Y: 1}).Len(), 3)
+}
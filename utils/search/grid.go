@@ -0,0 +1,50 @@
+package search
+
+import (
+	"iter"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/container/grid"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+// Grid lets the searches walk a grid in the four cardinal directions, cells
+// for which Wall returns true can't be entered.
+type Grid[T comparable] struct {
+	grid.Grid[T]
+	Wall func(T) bool
+}
+
+// Neighbors yields the open cells next to p, use it with BFS.
+func (g Grid[T]) Neighbors(p point.Point) iter.Seq[point.Point] {
+	return func(yield func(point.Point) bool) {
+		for _, nb := range p.Neighbors4() {
+			v, err := g.At(nb)
+			if err != nil || (g.Wall != nil && g.Wall(v)) {
+				continue
+			}
+			if !yield(nb) {
+				return
+			}
+		}
+	}
+}
+
+// Edges yields the open cells next to p at cost 1, use it with Dijkstra and
+// AStar.
+func (g Grid[T]) Edges(p point.Point) iter.Seq2[point.Point, int] {
+	return func(yield func(point.Point, int) bool) {
+		for nb := range g.Neighbors(p) {
+			if !yield(nb, 1) {
+				return
+			}
+		}
+	}
+}
+
+// Manhattan is an AStar heuristic for grids without diagonal steps.
+func Manhattan(goal point.Point) func(point.Point) int {
+	return func(p point.Point) int {
+		return utils.Abs(p.X-goal.X) + utils.Abs(p.Y-goal.Y)
+	}
+}
@@ -0,0 +1,112 @@
+// Package search finds shortest paths through graphs given as a start state
+// and a function yielding the neighbors of a state.
+package search
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/0x28F4/aoc2024/utils/pq"
+)
+
+// Result holds what a search found out about every state it reached.
+type Result[S comparable] struct {
+	Start S
+	// Dist is the cost of the cheapest known path from Start.
+	Dist map[S]int
+	// Prev is the state before each one on its cheapest path.
+	Prev map[S]S
+	// Goal is the first state the goal predicate accepted, if Found.
+	Goal  S
+	Found bool
+}
+
+func newResult[S comparable](start S) Result[S] {
+	return Result[S]{
+		Start: start,
+		Dist:  map[S]int{start: 0},
+		Prev:  map[S]S{},
+	}
+}
+
+// Distance returns the cost of reaching s, ok is false if s wasn't reached.
+func (r Result[S]) Distance(s S) (dist int, ok bool) {
+	dist, ok = r.Dist[s]
+	return
+}
+
+// Path returns the states from Start to s, nil if s wasn't reached.
+func (r Result[S]) Path(s S) []S {
+	if _, ok := r.Dist[s]; !ok {
+		return nil
+	}
+	path := []S{s}
+	for s != r.Start {
+		s = r.Prev[s]
+		path = append(path, s)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// BFS searches a graph where every step costs 1. It stops at the first state
+// accepted by goal, a nil goal explores everything reachable.
+func BFS[S comparable](start S, neighbors func(S) iter.Seq[S], goal func(S) bool) Result[S] {
+	r := newResult(start)
+	queue := []S{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if goal != nil && goal(cur) {
+			r.Goal, r.Found = cur, true
+			return r
+		}
+
+		for nxt := range neighbors(cur) {
+			if _, seen := r.Dist[nxt]; seen {
+				continue
+			}
+			r.Dist[nxt] = r.Dist[cur] + 1
+			r.Prev[nxt] = cur
+			queue = append(queue, nxt)
+		}
+	}
+	return r
+}
+
+// Dijkstra searches a graph with non negative step costs, neighbors yields
+// each neighbor together with the cost of stepping there.
+func Dijkstra[S comparable](start S, neighbors func(S) iter.Seq2[S, int], goal func(S) bool) Result[S] {
+	return AStar(start, neighbors, goal, nil)
+}
+
+// AStar is Dijkstra guided by heuristic, which must never overestimate the
+// remaining cost to the goal. A nil heuristic is the same as Dijkstra.
+func AStar[S comparable](start S, neighbors func(S) iter.Seq2[S, int], goal func(S) bool, heuristic func(S) int) Result[S] {
+	h := func(S) int { return 0 }
+	if heuristic != nil {
+		h = heuristic
+	}
+
+	r := newResult(start)
+	open := pq.NewIndexedMin[S, int]()
+	open.Push(start, h(start))
+	for open.Len() > 0 {
+		cur, _ := open.Pop()
+		if goal != nil && goal(cur) {
+			r.Goal, r.Found = cur, true
+			return r
+		}
+
+		for nxt, cost := range neighbors(cur) {
+			dist := r.Dist[cur] + cost
+			if known, seen := r.Dist[nxt]; seen && known <= dist {
+				continue
+			}
+			r.Dist[nxt] = dist
+			r.Prev[nxt] = cur
+			open.Push(nxt, dist+h(nxt))
+		}
+	}
+	return r
+}
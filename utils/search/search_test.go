@@ -0,0 +1,108 @@
+package search
+
+import (
+	"iter"
+	"maps"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+	"github.com/0x28F4/aoc2024/utils/parse"
+	"github.com/0x28F4/aoc2024/utils/point"
+)
+
+// graph is a small weighted, directed graph:
+//
+//	a -1-> b -1-> c -1-> d
+//	a ----5----------> d
+//	b -------2-------> d
+var graph = map[string]map[string]int{
+	"a": {"b": 1, "d": 5},
+	"b": {"c": 1, "d": 2},
+	"c": {"d": 1},
+	"d": {},
+	"e": {"a": 1},
+}
+
+func edges(s string) iter.Seq2[string, int] {
+	return maps.All(graph[s])
+}
+
+func unweighted(s string) iter.Seq[string] {
+	return maps.Keys(graph[s])
+}
+
+func is(want string) func(string) bool {
+	return func(s string) bool { return s == want }
+}
+
+func TestBFS(t *testing.T) {
+	r := BFS("a", unweighted, is("d"))
+	utils.MustTrue(r.Found)
+	utils.MustEq(r.Dist["d"], 1)
+	utils.MustSliceEq(r.Path("d"), []string{"a", "d"})
+
+	r = BFS("a", unweighted, nil)
+	utils.MustFalse(r.Found)
+	utils.MustEq(len(r.Dist), 4)
+	utils.MustEq(r.Dist["c"], 2)
+	_, ok := r.Distance("e")
+	utils.MustFalse(ok)
+	utils.MustLen(r.Path("e"), 0)
+}
+
+func TestDijkstra(t *testing.T) {
+	r := Dijkstra("a", edges, is("d"))
+	utils.MustTrue(r.Found)
+	dist, ok := r.Distance("d")
+	utils.MustTrue(ok)
+	utils.MustEq(dist, 3)
+	// both a-b-d and a-b-c-d cost 3, either is fine
+	path := r.Path("d")
+	utils.MustEq(path[0], "a")
+	utils.MustEq(path[len(path)-1], "d")
+
+	r = Dijkstra("e", edges, is("x"))
+	utils.MustFalse(r.Found)
+	utils.MustEq(r.Dist["d"], 4)
+}
+
+const maze = `
+S.#.....
+.##.###.
+....#...
+.##...#E`
+
+func TestGrid(t *testing.T) {
+	g, err := parse.Grid(parse.Lines(maze[1:]), parse.Byte)
+	utils.MustNil(err)
+	start, err := g.FindFirst('S')
+	utils.MustNil(err)
+	end, err := g.FindFirst('E')
+	utils.MustNil(err)
+
+	walls := Grid[byte]{g, func(b byte) bool { return b == '#' }}
+	isEnd := func(p point.Point) bool { return p == end }
+
+	bfs := BFS(start, walls.Neighbors, isEnd)
+	utils.MustTrue(bfs.Found)
+	utils.MustEq(bfs.Dist[end], 12)
+
+	astar := AStar(start, walls.Edges, isEnd, Manhattan(end))
+	utils.MustEq(astar.Dist[end], 12)
+
+	path := astar.Path(end)
+	utils.MustLen(path, 13)
+	for i, p := range path {
+		v, err := g.At(p)
+		utils.MustNil(err)
+		utils.MustNotEq(v, byte('#'))
+		if i > 0 {
+			utils.MustEq(utils.Abs(p.X-path[i-1].X)+utils.Abs(p.Y-path[i-1].Y), 1)
+		}
+	}
+
+	// walled in
+	utils.MustNil(g.Set(point.Point{X: 1, Y: 0}, '#'))
+	utils.MustNil(g.Set(point.Point{X: 0, Y: 1}, '#'))
+	utils.MustFalse(BFS(start, walls.Neighbors, isEnd).Found)
+}
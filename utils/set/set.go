@@ -1,5 +1,11 @@
 package set
 
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
 type Set[T comparable] map[T]struct{}
 
 func New[T comparable]() Set[T] {
@@ -42,3 +48,89 @@ func (s Set[T]) First() T {
 	}
 	panic("called First on set with size 0")
 }
+
+func FromSlice[T comparable](items []T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+	return s
+}
+
+// Clone returns a copy of s, which is never nil even if s is.
+func (s Set[T]) Clone() Set[T] {
+	ret := make(Set[T], len(s))
+	maps.Copy(ret, s)
+	return ret
+}
+
+// All yields the items in no particular order.
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// Sorted returns the items ordered by cmp, like slices.SortFunc.
+func (s Set[T]) Sorted(cmp func(a, b T) int) []T {
+	return slices.SortedFunc(s.All(), cmp)
+}
+
+// Union returns a new set of the items in s or other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	ret := make(Set[T], len(s)+len(other))
+	maps.Copy(ret, s)
+	maps.Copy(ret, other)
+	return ret
+}
+
+// Intersect returns a new set of the items in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	ret := New[T]()
+	for item := range small {
+		if large.Contains(item) {
+			ret[item] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// Difference returns a new set of the items in s but not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	ret := New[T]()
+	for item := range s {
+		if !other.Contains(item) {
+			ret[item] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// SymmetricDifference returns a new set of the items in exactly one of s
+// and other.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	ret := s.Difference(other)
+	for item := range other {
+		if !s.Contains(item) {
+			ret[item] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// IsSubset reports whether every item of s is in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for item := range s {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
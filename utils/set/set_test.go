@@ -0,0 +1,67 @@
+package set
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/0x28F4/aoc2024/utils"
+)
+
+func TestAlgebra(t *testing.T) {
+	a := FromSlice([]int{1, 2, 3, 4})
+	b := FromSlice([]int{3, 4, 5})
+
+	utils.MustSliceEq(a.Union(b).Sorted(cmp.Compare), []int{1, 2, 3, 4, 5})
+	utils.MustSliceEq(a.Intersect(b).Sorted(cmp.Compare), []int{3, 4})
+	utils.MustSliceEq(b.Intersect(a).Sorted(cmp.Compare), []int{3, 4})
+	utils.MustSliceEq(a.Difference(b).Sorted(cmp.Compare), []int{1, 2})
+	utils.MustSliceEq(b.Difference(a).Sorted(cmp.Compare), []int{5})
+	utils.MustSliceEq(a.SymmetricDifference(b).Sorted(cmp.Compare), []int{1, 2, 5})
+
+	// none of them touch their operands
+	utils.MustEq(a.Len(), 4)
+	utils.MustEq(b.Len(), 3)
+
+	utils.MustTrue(FromSlice([]int{3, 4}).IsSubset(a))
+	utils.MustFalse(b.IsSubset(a))
+	utils.MustTrue(New[int]().IsSubset(a))
+	utils.MustTrue(a.IsSubset(a))
+
+	utils.MustTrue(a.Equal(FromSlice([]int{4, 3, 2, 1, 1})))
+	utils.MustFalse(a.Equal(b))
+	utils.MustFalse(FromSlice([]int{1, 2, 3, 5}).Equal(a))
+}
+
+func TestClone(t *testing.T) {
+	a := FromSlice([]string{"x", "y"})
+	c := a.Clone()
+	c.Add("z")
+	c.Rem("x")
+	utils.MustSliceEq(a.Sorted(cmp.Compare), []string{"x", "y"})
+	utils.MustSliceEq(c.Sorted(cmp.Compare), []string{"y", "z"})
+
+	var empty Set[string]
+	empty.Clone().Add("x")
+	u := empty.Union(a)
+	u.Add("z")
+	utils.MustSliceEq(u.Sorted(cmp.Compare), []string{"x", "y", "z"})
+	utils.MustEq(len(empty), 0)
+}
+
+func TestAll(t *testing.T) {
+	a := FromSlice([]int{5, 6, 7})
+	seen := New[int]()
+	for item := range a.All() {
+		seen.Add(item)
+	}
+	utils.MustTrue(seen.Equal(a))
+
+	n := 0
+	for range a.All() {
+		n++
+		break
+	}
+	utils.MustEq(n, 1)
+
+	utils.MustSliceEq(a.Sorted(func(x, y int) int { return y - x }), []int{7, 6, 5})
+}
@@ -82,25 +82,25 @@ func MustFalse(v bool) {
 
 func MustSmaller[T cmp.Ordered](actual T, other T) {
 	if actual >= other {
-		panic(fmt.Sprintf("actual %d is greater or equal than other %d", actual, other))
+		panic(fmt.Sprintf("actual %v is greater or equal than other %v", actual, other))
 	}
 }
 
 func MustGreater[T cmp.Ordered](actual T, other T) {
 	if actual <= other {
-		panic(fmt.Sprintf("actual %d is smaller or equal than other %d", actual, other))
+		panic(fmt.Sprintf("actual %v is smaller or equal than other %v", actual, other))
 	}
 }
 
 func MustSmallerEq[T cmp.Ordered](actual T, other T) {
 	if actual > other {
-		panic(fmt.Sprintf("actual %d is greater than other %d", actual, other))
+		panic(fmt.Sprintf("actual %v is greater than other %v", actual, other))
 	}
 }
 
 func MustGreaterEq[T cmp.Ordered](actual T, other T) {
 	if actual < other {
-		panic(fmt.Sprintf("actual %d is smaller than other %d", actual, other))
+		panic(fmt.Sprintf("actual %v is smaller than other %v", actual, other))
 	}
 }
 